@@ -29,6 +29,42 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// PolicyLint configures how findings of the Policy linter are handled.
+	// +optional
+	PolicyLint *PolicyLintConfig `json:"policyLint,omitempty"`
+}
+
+// A PolicyLintFinding is a kind of problem the Policy linter detects.
+// +kubebuilder:validation:Enum=SudoGrant;SysWildcard;ConflictingDuplicate;DenyWithOthers;UnknownCapability
+type PolicyLintFinding string
+
+// Policy linter findings.
+const (
+	// PolicyLintSudoGrant is a rule granting the sudo capability.
+	PolicyLintSudoGrant PolicyLintFinding = "SudoGrant"
+
+	// PolicyLintSysWildcard is a glob rule covering the whole sys/ backend.
+	PolicyLintSysWildcard PolicyLintFinding = "SysWildcard"
+
+	// PolicyLintConflictingDuplicate is a path declared more than once with
+	// different capabilities.
+	PolicyLintConflictingDuplicate PolicyLintFinding = "ConflictingDuplicate"
+
+	// PolicyLintDenyWithOthers is a rule combining deny with other
+	// capabilities, which Vault silently drops.
+	PolicyLintDenyWithOthers PolicyLintFinding = "DenyWithOthers"
+
+	// PolicyLintUnknownCapability is a capability Vault does not know.
+	PolicyLintUnknownCapability PolicyLintFinding = "UnknownCapability"
+)
+
+// PolicyLintConfig configures the Policy linter.
+type PolicyLintConfig struct {
+	// Reject lists the findings that prevent a Policy from being written to
+	// Vault. Other findings are only reported.
+	// +optional
+	Reject []PolicyLintFinding `json:"reject,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
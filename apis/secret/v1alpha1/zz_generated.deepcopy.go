@@ -26,6 +26,26 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyLintConfig) DeepCopyInto(out *PolicyLintConfig) {
+	*out = *in
+	if in.Reject != nil {
+		in, out := &in.Reject, &out.Reject
+		*out = make([]PolicyLintFinding, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyLintConfig.
+func (in *PolicyLintConfig) DeepCopy() *PolicyLintConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyLintConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
@@ -89,6 +109,11 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
 	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.PolicyLint != nil {
+		in, out := &in.PolicyLint, &out.PolicyLint
+		*out = new(PolicyLintConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
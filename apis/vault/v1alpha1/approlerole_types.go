@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection details published by an AppRoleRole.
+const (
+	ConnectionKeyRoleID   = "role_id"
+	ConnectionKeySecretID = "secret_id"
+)
+
+// AppRoleRoleParameters are the configurable fields of an AppRoleRole.
+type AppRoleRoleParameters struct {
+	// Mount is the path the approle auth method is enabled at, without the
+	// auth/ prefix.
+	// +kubebuilder:default=approle
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// SecretIDTTL is how long the issued secret_ids are valid. They never
+	// expire when unset.
+	// +optional
+	SecretIDTTL *metav1.Duration `json:"secretIDTTL,omitempty"`
+
+	// SecretIDNumUses is how many times the issued secret_ids can be used to
+	// log in. Zero allows unlimited logins.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SecretIDNumUses *int `json:"secretIDNumUses,omitempty"`
+
+	// SecretIDBoundCIDRs lists the CIDR blocks the issued secret_ids can be
+	// used from.
+	// +optional
+	SecretIDBoundCIDRs []string `json:"secretIDBoundCIDRs,omitempty"`
+
+	// SecretIDRotateBefore is how long before its expiry the published
+	// secret_id is replaced by a new one. Defaults to a third of
+	// secretIDTTL.
+	// +optional
+	SecretIDRotateBefore *metav1.Duration `json:"secretIDRotateBefore,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// AppRoleRoleObservation are the observable fields of an AppRoleRole.
+type AppRoleRoleObservation struct {
+	// Path of the role in Vault.
+	Path string `json:"path,omitempty"`
+
+	// RoleID of the role.
+	RoleID string `json:"roleID,omitempty"`
+
+	// SecretIDAccessor identifies the published secret_id.
+	SecretIDAccessor string `json:"secretIDAccessor,omitempty"`
+
+	// SecretIDExpiration is when the published secret_id expires. It is
+	// unset when the secret_id never expires.
+	SecretIDExpiration *metav1.Time `json:"secretIDExpiration,omitempty"`
+
+	// SupersededSecretIDAccessor identifies the secret_id the published one
+	// replaced. It stays valid until the reconcile after the rotation, so
+	// that clients never hold a destroyed secret_id.
+	SupersededSecretIDAccessor string `json:"supersededSecretIDAccessor,omitempty"`
+}
+
+// An AppRoleRoleSpec defines the desired state of an AppRoleRole.
+type AppRoleRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppRoleRoleParameters `json:"forProvider"`
+}
+
+// An AppRoleRoleStatus represents the observed state of an AppRoleRole.
+type AppRoleRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AppRoleRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AppRoleRole manages a role of the approle auth method and publishes its
+// role_id along with a secret_id, which is rotated before it expires. The
+// secret_id it replaces is destroyed once the new one is published.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SECRET-ID-EXPIRATION",type="string",JSONPath=".status.atProvider.secretIDExpiration"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type AppRoleRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppRoleRoleSpec   `json:"spec"`
+	Status AppRoleRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppRoleRoleList contains a list of AppRoleRole
+type AppRoleRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppRoleRole `json:"items"`
+}
+
+// AppRoleRole type metadata.
+var (
+	AppRoleRoleKind             = reflect.TypeOf(AppRoleRole{}).Name()
+	AppRoleRoleGroupKind        = schema.GroupKind{Group: Group, Kind: AppRoleRoleKind}.String()
+	AppRoleRoleKindAPIVersion   = AppRoleRoleKind + "." + SchemeGroupVersion.String()
+	AppRoleRoleGroupVersionKind = SchemeGroupVersion.WithKind(AppRoleRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AppRoleRole{}, &AppRoleRoleList{})
+}
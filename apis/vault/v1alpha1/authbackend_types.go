@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Kinds of auth methods.
+const (
+	AuthKubernetes = "kubernetes"
+	AuthAppRole    = "approle"
+	AuthJWT        = "jwt"
+	AuthOIDC       = "oidc"
+	AuthUserpass   = "userpass"
+	AuthCert       = "cert"
+	AuthToken      = "token"
+)
+
+// AuthBackendParameters are the configurable fields of an AuthBackend.
+// +kubebuilder:validation:XValidation:rule="self.type != 'token' || !has(self.path) || self.path == 'token'",message="the token auth method is always mounted at token"
+// +kubebuilder:validation:XValidation:rule="has(self.path) == has(oldSelf.path) && (!has(self.path) || self.path == oldSelf.path)",message="path is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.local) && self.local) == (has(oldSelf.local) && oldSelf.local)",message="local is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.sealWrap) && self.sealWrap) == (has(oldSelf.sealWrap) && oldSelf.sealWrap)",message="sealWrap is immutable"
+type AuthBackendParameters struct {
+	// Type is the kind of auth method. The token auth method is built into
+	// Vault, so it can only be tuned.
+	// +kubebuilder:validation:Enum=kubernetes;approle;jwt;oidc;userpass;cert;token
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// Path the auth method is enabled at, without the auth/ prefix. Defaults
+	// to the external name of the AuthBackend.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Description of the auth method.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// DefaultLeaseTTL is the default duration of the tokens issued by the
+	// auth method. The system default applies when unset.
+	// +optional
+	DefaultLeaseTTL *metav1.Duration `json:"defaultLeaseTTL,omitempty"`
+
+	// MaxLeaseTTL is the maximum duration of the tokens issued by the auth
+	// method. The system default applies when unset.
+	// +optional
+	MaxLeaseTTL *metav1.Duration `json:"maxLeaseTTL,omitempty"`
+
+	// ListingVisibility tells whether the auth method is listed in the UI to
+	// unauthenticated users.
+	// +kubebuilder:validation:Enum=hidden;unauth
+	// +optional
+	ListingVisibility string `json:"listingVisibility,omitempty"`
+
+	// TokenType is the type of the tokens issued by the auth method.
+	// +kubebuilder:validation:Enum=default-service;default-batch;service;batch
+	// +optional
+	TokenType string `json:"tokenType,omitempty"`
+
+	// AuditNonHMACRequestKeys lists the request keys the audit devices log
+	// without HMAC.
+	// +optional
+	AuditNonHMACRequestKeys []string `json:"auditNonHMACRequestKeys,omitempty"`
+
+	// AuditNonHMACResponseKeys lists the response keys the audit devices log
+	// without HMAC.
+	// +optional
+	AuditNonHMACResponseKeys []string `json:"auditNonHMACResponseKeys,omitempty"`
+
+	// PassthroughRequestHeaders lists the request headers passed to the auth
+	// method.
+	// +optional
+	PassthroughRequestHeaders []string `json:"passthroughRequestHeaders,omitempty"`
+
+	// AllowedResponseHeaders lists the response headers the auth method is
+	// allowed to set.
+	// +optional
+	AllowedResponseHeaders []string `json:"allowedResponseHeaders,omitempty"`
+
+	// Local enables the auth method on the local cluster only, so it is not
+	// replicated. It cannot be changed once enabled.
+	// +optional
+	Local bool `json:"local,omitempty"`
+
+	// SealWrap enables seal wrapping for the auth method. It cannot be
+	// changed once enabled.
+	// +optional
+	SealWrap bool `json:"sealWrap,omitempty"`
+}
+
+// TokenParameters are the settings of the tokens issued to the clients that
+// log in through an auth method role.
+type TokenParameters struct {
+	// TokenPolicies attached to the issued tokens, by their name in Vault.
+	// +optional
+	TokenPolicies []string `json:"tokenPolicies,omitempty"`
+
+	// TokenPolicyRefs reference the Policies attached to the issued tokens.
+	// They are resolved into tokenPolicies.
+	// +optional
+	TokenPolicyRefs []xpv1.Reference `json:"tokenPolicyRefs,omitempty"`
+
+	// TokenPolicySelector selects the Policies attached to the issued
+	// tokens. They are resolved into tokenPolicies.
+	// +optional
+	TokenPolicySelector *xpv1.Selector `json:"tokenPolicySelector,omitempty"`
+
+	// TokenTTL is the initial duration of the issued tokens.
+	// +optional
+	TokenTTL *metav1.Duration `json:"tokenTTL,omitempty"`
+
+	// TokenMaxTTL is the maximum duration the issued tokens can be renewed
+	// to.
+	// +optional
+	TokenMaxTTL *metav1.Duration `json:"tokenMaxTTL,omitempty"`
+
+	// TokenPeriod makes the issued tokens periodic, renewable for that long
+	// indefinitely.
+	// +optional
+	TokenPeriod *metav1.Duration `json:"tokenPeriod,omitempty"`
+
+	// TokenType is the type of the issued tokens.
+	// +kubebuilder:validation:Enum=default;default-service;default-batch;service;batch
+	// +optional
+	TokenType string `json:"tokenType,omitempty"`
+
+	// TokenBoundCIDRs lists the CIDR blocks the issued tokens can be used
+	// from.
+	// +optional
+	TokenBoundCIDRs []string `json:"tokenBoundCIDRs,omitempty"`
+
+	// TokenNoDefaultPolicy leaves the default policy out of the issued
+	// tokens.
+	// +optional
+	TokenNoDefaultPolicy bool `json:"tokenNoDefaultPolicy,omitempty"`
+}
+
+// AuthBackendObservation are the observable fields of an AuthBackend.
+type AuthBackendObservation struct {
+	// Path the auth method is enabled at.
+	Path string `json:"path,omitempty"`
+
+	// Accessor of the auth method, as used by identity aliases and
+	// templating.
+	Accessor string `json:"accessor,omitempty"`
+
+	// Type of the auth method.
+	Type string `json:"type,omitempty"`
+
+	// PluginVersion is the version of the plugin running the auth method.
+	PluginVersion string `json:"pluginVersion,omitempty"`
+
+	// UUID of the auth method.
+	UUID string `json:"uuid,omitempty"`
+
+	// DefaultLeaseTTL is the effective default token duration.
+	DefaultLeaseTTL *metav1.Duration `json:"defaultLeaseTTL,omitempty"`
+
+	// MaxLeaseTTL is the effective maximum token duration.
+	MaxLeaseTTL *metav1.Duration `json:"maxLeaseTTL,omitempty"`
+
+	// TokenType is the effective type of the issued tokens.
+	TokenType string `json:"tokenType,omitempty"`
+
+	// Local tells whether the auth method is not replicated.
+	Local bool `json:"local,omitempty"`
+
+	// SealWrap tells whether the auth method is seal wrapped.
+	SealWrap bool `json:"sealWrap,omitempty"`
+}
+
+// An AuthBackendSpec defines the desired state of an AuthBackend.
+type AuthBackendSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AuthBackendParameters `json:"forProvider"`
+}
+
+// An AuthBackendStatus represents the observed state of an AuthBackend.
+type AuthBackendStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AuthBackendObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AuthBackend enables and tunes a Vault auth method.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="ACCESSOR",type="string",JSONPath=".status.atProvider.accessor"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type AuthBackend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthBackendSpec   `json:"spec"`
+	Status AuthBackendStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthBackendList contains a list of AuthBackend
+type AuthBackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthBackend `json:"items"`
+}
+
+// AuthBackend type metadata.
+var (
+	AuthBackendKind             = reflect.TypeOf(AuthBackend{}).Name()
+	AuthBackendGroupKind        = schema.GroupKind{Group: Group, Kind: AuthBackendKind}.String()
+	AuthBackendKindAPIVersion   = AuthBackendKind + "." + SchemeGroupVersion.String()
+	AuthBackendGroupVersionKind = SchemeGroupVersion.WithKind(AuthBackendKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AuthBackend{}, &AuthBackendList{})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Key whose value is selected.
+	Key string `json:"key"`
+}
+
+// CertAuthRoleParameters are the configurable fields of a CertAuthRole.
+// +kubebuilder:validation:XValidation:rule="has(self.certificateSecretRef) != has(self.certificateConfigMapRef)",message="exactly one of certificateSecretRef and certificateConfigMapRef must be set"
+type CertAuthRoleParameters struct {
+	// Mount is the path the cert auth method is enabled at, without the
+	// auth/ prefix.
+	// +kubebuilder:default=cert
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// CertificateSecretRef selects the PEM encoded CA certificate the client
+	// certificates must chain to.
+	// +optional
+	CertificateSecretRef *xpv1.SecretKeySelector `json:"certificateSecretRef,omitempty"`
+
+	// CertificateConfigMapRef selects the PEM encoded CA certificate the
+	// client certificates must chain to.
+	// +optional
+	CertificateConfigMapRef *ConfigMapKeySelector `json:"certificateConfigMapRef,omitempty"`
+
+	// AllowedCommonNames lists the common names the client certificates may
+	// have. Globs are supported.
+	// +optional
+	AllowedCommonNames []string `json:"allowedCommonNames,omitempty"`
+
+	// AllowedDNSSANs lists the DNS subject alternative names the client
+	// certificates may have. Globs are supported.
+	// +optional
+	AllowedDNSSANs []string `json:"allowedDNSSANs,omitempty"`
+
+	// AllowedOrganizationalUnits lists the organizational units the client
+	// certificates may have. Globs are supported.
+	// +optional
+	AllowedOrganizationalUnits []string `json:"allowedOrganizationalUnits,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// CertAuthRoleObservation are the observable fields of a CertAuthRole.
+type CertAuthRoleObservation struct {
+	// Path of the role in Vault.
+	Path string `json:"path,omitempty"`
+
+	// TokenPolicies attached to the issued tokens, as Vault reports them.
+	TokenPolicies []string `json:"tokenPolicies,omitempty"`
+}
+
+// A CertAuthRoleSpec defines the desired state of a CertAuthRole.
+type CertAuthRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertAuthRoleParameters `json:"forProvider"`
+}
+
+// A CertAuthRoleStatus represents the observed state of a CertAuthRole.
+type CertAuthRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertAuthRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CertAuthRole lets the holders of client certificates issued by a CA log
+// in to Vault through the cert auth method.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type CertAuthRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertAuthRoleSpec   `json:"spec"`
+	Status CertAuthRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertAuthRoleList contains a list of CertAuthRole
+type CertAuthRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CertAuthRole `json:"items"`
+}
+
+// CertAuthRole type metadata.
+var (
+	CertAuthRoleKind             = reflect.TypeOf(CertAuthRole{}).Name()
+	CertAuthRoleGroupKind        = schema.GroupKind{Group: Group, Kind: CertAuthRoleKind}.String()
+	CertAuthRoleKindAPIVersion   = CertAuthRoleKind + "." + SchemeGroupVersion.String()
+	CertAuthRoleGroupVersionKind = SchemeGroupVersion.WithKind(CertAuthRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CertAuthRole{}, &CertAuthRoleList{})
+}
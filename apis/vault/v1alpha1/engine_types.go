@@ -19,21 +19,253 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Kinds of secrets engines.
+const (
+	EngineKV         = "kv"
+	EngineTransit    = "transit"
+	EnginePKI        = "pki"
+	EngineDatabase   = "database"
+	EngineSSH        = "ssh"
+	EngineTOTP       = "totp"
+	EngineKubernetes = "kubernetes"
+	EnginePlugin     = "plugin"
+)
+
+// KVOptions are the mount options of a kv engine.
+type KVOptions struct {
+	// Version of the kv engine.
+	// +kubebuilder:validation:Enum="1";"2"
+	Version string `json:"version"`
+}
+
+// PluginOptions identify the plugin running a plugin engine.
+type PluginOptions struct {
+	// Name of the plugin as registered in the plugin catalog.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Version of the plugin to run. The pinned or builtin version applies
+	// when unset.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
 // EngineParameters are the configurable fields of a Engine.
+// +kubebuilder:validation:XValidation:rule="self.storage == 'plugin' ? has(self.plugin) : !has(self.plugin)",message="plugin must be set for plugin engines, and only for them"
+// +kubebuilder:validation:XValidation:rule="!has(self.kv) || self.storage == 'kv'",message="kv options only apply to kv engines"
+// +kubebuilder:validation:XValidation:rule="!has(self.kvConfig) || self.storage == 'kv'",message="kvConfig only applies to kv engines"
+// +kubebuilder:validation:XValidation:rule="(has(self.local) && self.local) == (has(oldSelf.local) && oldSelf.local)",message="local is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.sealWrap) && self.sealWrap) == (has(oldSelf.sealWrap) && oldSelf.sealWrap)",message="sealWrap is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.externalEntropyAccess) && self.externalEntropyAccess) == (has(oldSelf.externalEntropyAccess) && oldSelf.externalEntropyAccess)",message="externalEntropyAccess is immutable"
 type EngineParameters struct {
-	Storage string            `json:"storage"`
-	Options map[string]string `json:"options"`
+	// Storage is the kind of secrets engine. Use plugin, along with the
+	// plugin parameter, for any other engine. Engines declared with another
+	// storage must be changed that way before they can be updated, see the
+	// README.
+	// +kubebuilder:validation:Enum=kv;transit;pki;database;ssh;totp;kubernetes;plugin
+	Storage string `json:"storage"`
+
+	// Options are passed to the engine as they are. Typed options take
+	// precedence over them. Of the builtin engines only kv reads mount
+	// options, hence the kv parameter: transit, pki, database, ssh, totp and
+	// kubernetes read none. Their own configuration, such as pki URLs or
+	// database connections, is written to their endpoints rather than to the
+	// mount, and is not managed by the Engine yet.
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+
+	// KV are the options of a kv engine.
+	// +optional
+	KV *KVOptions `json:"kv,omitempty"`
+
+	// Plugin identifies the plugin running a plugin engine.
+	// +optional
+	Plugin *PluginOptions `json:"plugin,omitempty"`
+
+	// Local mounts the engine on the local cluster only, so it is not
+	// replicated. It cannot be changed once mounted.
+	// +optional
+	Local bool `json:"local,omitempty"`
+
+	// SealWrap enables seal wrapping for the engine. It cannot be changed
+	// once mounted.
+	// +optional
+	SealWrap bool `json:"sealWrap,omitempty"`
+
+	// ExternalEntropyAccess gives the engine access to the external entropy
+	// source of Vault. It cannot be changed once mounted.
+	// +optional
+	ExternalEntropyAccess bool `json:"externalEntropyAccess,omitempty"`
+
+	// Path the engine is mounted at, which may be nested like "team-a/kv".
+	// Defaults to the external name of the Engine.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Description of the mount.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// DefaultLeaseTTL is the default lease duration of the mount. The system
+	// default applies when unset.
+	// +optional
+	DefaultLeaseTTL *metav1.Duration `json:"defaultLeaseTTL,omitempty"`
+
+	// MaxLeaseTTL is the maximum lease duration of the mount. The system
+	// default applies when unset.
+	// +optional
+	MaxLeaseTTL *metav1.Duration `json:"maxLeaseTTL,omitempty"`
+
+	// ListingVisibility tells whether the mount is listed in the UI to
+	// unauthenticated users.
+	// +kubebuilder:validation:Enum=hidden;unauth
+	// +optional
+	ListingVisibility string `json:"listingVisibility,omitempty"`
+
+	// AuditNonHMACRequestKeys lists the request keys the audit devices log
+	// without HMAC.
+	// +optional
+	AuditNonHMACRequestKeys []string `json:"auditNonHMACRequestKeys,omitempty"`
+
+	// PassthroughRequestHeaders lists the request headers passed to the
+	// secrets engine.
+	// +optional
+	PassthroughRequestHeaders []string `json:"passthroughRequestHeaders,omitempty"`
+
+	// AllowedResponseHeaders lists the response headers the secrets engine is
+	// allowed to set.
+	// +optional
+	AllowedResponseHeaders []string `json:"allowedResponseHeaders,omitempty"`
+
+	// KVConfig is the backend configuration of a kv version 2 engine.
+	// +optional
+	KVConfig *KVConfig `json:"kvConfig,omitempty"`
+
+	// ForceDestroy unmounts the engine on deletion even when it still holds
+	// secrets or is referenced by SecretPaths.
+	// +optional
+	ForceDestroy bool `json:"forceDestroy,omitempty"`
+}
+
+// KVConfig is the backend configuration of a kv version 2 engine. Settings
+// left unset keep the value Vault holds.
+type KVConfig struct {
+	// MaxVersions is the number of versions kept per key. Zero keeps the
+	// Vault default of 10.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxVersions *int `json:"maxVersions,omitempty"`
+
+	// CASRequired requires the check-and-set parameter on every write.
+	// +optional
+	CASRequired *bool `json:"casRequired,omitempty"`
+
+	// DeleteVersionAfter is how long versions are kept before being deleted.
+	// Zero keeps them forever.
+	// +optional
+	DeleteVersionAfter *metav1.Duration `json:"deleteVersionAfter,omitempty"`
+}
+
+// TypeUpgrading is the condition reporting an in-place upgrade of a kv engine
+// from version 1 to version 2.
+const TypeUpgrading xpv1.ConditionType = "Upgrading"
+
+// Reasons of the Upgrading condition.
+const (
+	ReasonUpgradeInProgress xpv1.ConditionReason = "UpgradeInProgress"
+	ReasonUpgradeComplete   xpv1.ConditionReason = "UpgradeComplete"
+)
+
+// Upgrading returns a condition that indicates a kv engine is being upgraded
+// to version 2 and is unavailable meanwhile.
+func Upgrading(message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeInProgress,
+		Message:            message,
+	}
+}
+
+// UpgradeComplete returns a condition that indicates no upgrade is running.
+func UpgradeComplete() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeComplete,
+	}
+}
+
+// AnnotationKeyForceDestroy forces the deletion of an Engine, like its
+// forceDestroy parameter, when set to "true".
+const AnnotationKeyForceDestroy = "vault.secret.crossplane.io/force-destroy"
+
+// Remount statuses reported by Vault.
+const (
+	RemountInProgress = "in-progress"
+	RemountSuccess    = "success"
+	RemountFailure    = "failure"
+)
+
+// EngineRemount tracks the move of a mount to a new path.
+type EngineRemount struct {
+	// From is the path the mount is moved from.
+	From string `json:"from"`
+
+	// To is the path the mount is moved to.
+	To string `json:"to"`
+
+	// MigrationID identifies the operation in Vault. It is empty when Vault
+	// moved the mount synchronously.
+	MigrationID string `json:"migrationID,omitempty"`
+
+	// Status of the operation: in-progress, success or failure.
+	Status string `json:"status,omitempty"`
 }
 
 // EngineObservation are the observable fields of a Engine.
 type EngineObservation struct {
-	ObservableField string `json:"observableField,omitempty"`
+	// Path the engine is currently mounted at.
+	Path string `json:"path,omitempty"`
+
+	// Remount is the last move of the mount to a new path.
+	Remount *EngineRemount `json:"remount,omitempty"`
+
+	// Accessor of the mount, as used by identity templating.
+	Accessor string `json:"accessor,omitempty"`
+
+	// Type of the secrets engine.
+	Type string `json:"type,omitempty"`
+
+	// PluginVersion is the version of the plugin running the mount.
+	PluginVersion string `json:"pluginVersion,omitempty"`
+
+	// UUID of the mount.
+	UUID string `json:"uuid,omitempty"`
+
+	// KVVersion is the version of a kv secrets engine.
+	KVVersion string `json:"kvVersion,omitempty"`
+
+	// DefaultLeaseTTL is the effective default lease duration of the mount.
+	DefaultLeaseTTL *metav1.Duration `json:"defaultLeaseTTL,omitempty"`
+
+	// MaxLeaseTTL is the effective maximum lease duration of the mount.
+	MaxLeaseTTL *metav1.Duration `json:"maxLeaseTTL,omitempty"`
+
+	// Local tells whether the mount is not replicated.
+	Local bool `json:"local,omitempty"`
+
+	// SealWrap tells whether the mount is seal wrapped.
+	SealWrap bool `json:"sealWrap,omitempty"`
 }
 
 // A EngineSpec defines the desired state of a Engine.
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// JWTAuthConfigParameters are the configurable fields of a JWTAuthConfig.
+// +kubebuilder:validation:XValidation:rule="[has(self.oidcDiscoveryURL), has(self.jwksURL), has(self.jwtValidationPubkeys)].filter(x, x).size() == 1",message="exactly one of oidcDiscoveryURL, jwksURL and jwtValidationPubkeys must be set"
+type JWTAuthConfigParameters struct {
+	// Mount is the path the jwt or oidc auth method is enabled at, without
+	// the auth/ prefix.
+	// +kubebuilder:default=jwt
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// OIDCDiscoveryURL is the URL of the OIDC provider, used to discover its
+	// keys and, for the oidc auth method, its endpoints.
+	// +optional
+	OIDCDiscoveryURL string `json:"oidcDiscoveryURL,omitempty"`
+
+	// OIDCDiscoveryCAPEM is the PEM encoded CA certificate of the OIDC
+	// discovery URL. The system trust store applies when unset.
+	// +optional
+	OIDCDiscoveryCAPEM string `json:"oidcDiscoveryCAPEM,omitempty"`
+
+	// OIDCClientID is the client ID Vault uses with the OIDC provider.
+	// +optional
+	OIDCClientID string `json:"oidcClientID,omitempty"`
+
+	// OIDCClientSecretSecretRef selects the client secret Vault uses with
+	// the OIDC provider.
+	// +optional
+	OIDCClientSecretSecretRef *xpv1.SecretKeySelector `json:"oidcClientSecretSecretRef,omitempty"`
+
+	// JWKSURL is the URL of the JSON Web Key Set the tokens are verified
+	// against.
+	// +optional
+	JWKSURL string `json:"jwksURL,omitempty"`
+
+	// JWKSCAPEM is the PEM encoded CA certificate of the JWKS URL. The
+	// system trust store applies when unset.
+	// +optional
+	JWKSCAPEM string `json:"jwksCAPEM,omitempty"`
+
+	// JWTValidationPubkeys lists the PEM encoded public keys the tokens are
+	// verified against.
+	// +optional
+	JWTValidationPubkeys []string `json:"jwtValidationPubkeys,omitempty"`
+
+	// BoundIssuer the tokens must be issued by.
+	// +optional
+	BoundIssuer string `json:"boundIssuer,omitempty"`
+
+	// JWTSupportedAlgs lists the signing algorithms accepted.
+	// +optional
+	JWTSupportedAlgs []string `json:"jwtSupportedAlgs,omitempty"`
+
+	// DefaultRole used when a login names no role.
+	// +optional
+	DefaultRole string `json:"defaultRole,omitempty"`
+}
+
+// JWTAuthConfigObservation are the observable fields of a JWTAuthConfig.
+type JWTAuthConfigObservation struct {
+	// Path of the configuration in Vault.
+	Path string `json:"path,omitempty"`
+
+	// SecretsHash is the SHA-256 checksum of the OIDC client secret last
+	// written to Vault, which tells when the Secret rotates.
+	SecretsHash string `json:"secretsHash,omitempty"`
+}
+
+// A JWTAuthConfigSpec defines the desired state of a JWTAuthConfig.
+type JWTAuthConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JWTAuthConfigParameters `json:"forProvider"`
+}
+
+// A JWTAuthConfigStatus represents the observed state of a JWTAuthConfig.
+type JWTAuthConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JWTAuthConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JWTAuthConfig configures how the jwt or oidc auth method verifies tokens.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MOUNT",type="string",JSONPath=".spec.forProvider.mount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type JWTAuthConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JWTAuthConfigSpec   `json:"spec"`
+	Status JWTAuthConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JWTAuthConfigList contains a list of JWTAuthConfig
+type JWTAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JWTAuthConfig `json:"items"`
+}
+
+// JWTAuthConfig type metadata.
+var (
+	JWTAuthConfigKind             = reflect.TypeOf(JWTAuthConfig{}).Name()
+	JWTAuthConfigGroupKind        = schema.GroupKind{Group: Group, Kind: JWTAuthConfigKind}.String()
+	JWTAuthConfigKindAPIVersion   = JWTAuthConfigKind + "." + SchemeGroupVersion.String()
+	JWTAuthConfigGroupVersionKind = SchemeGroupVersion.WithKind(JWTAuthConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&JWTAuthConfig{}, &JWTAuthConfigList{})
+}
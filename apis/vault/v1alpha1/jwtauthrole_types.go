@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// JWTAuthRoleParameters are the configurable fields of a JWTAuthRole.
+// +kubebuilder:validation:XValidation:rule="!has(self.allowedRedirectURIs) || self.roleType == 'oidc'",message="allowedRedirectURIs only apply to oidc roles"
+// +kubebuilder:validation:XValidation:rule="self.roleType != 'oidc' || has(self.allowedRedirectURIs)",message="oidc roles need allowedRedirectURIs"
+type JWTAuthRoleParameters struct {
+	// Mount is the path the jwt or oidc auth method is enabled at, without
+	// the auth/ prefix.
+	// +kubebuilder:default=jwt
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// RoleType tells whether clients log in with a JWT, or through the OIDC
+	// flow in a browser.
+	// +kubebuilder:validation:Enum=jwt;oidc
+	// +kubebuilder:default=jwt
+	// +optional
+	RoleType string `json:"roleType,omitempty"`
+
+	// BoundAudiences lists the audiences the tokens must be issued for.
+	// +optional
+	BoundAudiences []string `json:"boundAudiences,omitempty"`
+
+	// BoundSubject is the subject the tokens must be issued to.
+	// +optional
+	BoundSubject string `json:"boundSubject,omitempty"`
+
+	// BoundClaims maps claims to the values they must hold. A claim matches
+	// when it holds any of its values.
+	// +optional
+	BoundClaims map[string][]string `json:"boundClaims,omitempty"`
+
+	// BoundClaimsType tells whether the values of boundClaims are matched as
+	// they are, or as glob patterns.
+	// +kubebuilder:validation:Enum=string;glob
+	// +optional
+	BoundClaimsType string `json:"boundClaimsType,omitempty"`
+
+	// ClaimMappings maps claims to the metadata keys they are copied to.
+	// +optional
+	ClaimMappings map[string]string `json:"claimMappings,omitempty"`
+
+	// UserClaim names the claim the identity alias is named after.
+	// +kubebuilder:validation:MinLength=1
+	UserClaim string `json:"userClaim"`
+
+	// GroupsClaim names the claim holding the groups of the user.
+	// +optional
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+
+	// AllowedRedirectURIs lists the redirect URIs allowed in the OIDC flow.
+	// +optional
+	AllowedRedirectURIs []string `json:"allowedRedirectURIs,omitempty"`
+
+	// OIDCScopes lists the scopes requested in the OIDC flow, besides
+	// openid.
+	// +optional
+	OIDCScopes []string `json:"oidcScopes,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// JWTAuthRoleObservation are the observable fields of a JWTAuthRole.
+type JWTAuthRoleObservation struct {
+	// Path of the role in Vault.
+	Path string `json:"path,omitempty"`
+
+	// TokenPolicies attached to the issued tokens, as Vault reports them.
+	TokenPolicies []string `json:"tokenPolicies,omitempty"`
+}
+
+// A JWTAuthRoleSpec defines the desired state of a JWTAuthRole.
+type JWTAuthRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JWTAuthRoleParameters `json:"forProvider"`
+}
+
+// A JWTAuthRoleStatus represents the observed state of a JWTAuthRole.
+type JWTAuthRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JWTAuthRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JWTAuthRole lets the bearers of JWTs, or the users of an OIDC provider,
+// log in to Vault through the jwt or oidc auth method.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type JWTAuthRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JWTAuthRoleSpec   `json:"spec"`
+	Status JWTAuthRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JWTAuthRoleList contains a list of JWTAuthRole
+type JWTAuthRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JWTAuthRole `json:"items"`
+}
+
+// JWTAuthRole type metadata.
+var (
+	JWTAuthRoleKind             = reflect.TypeOf(JWTAuthRole{}).Name()
+	JWTAuthRoleGroupKind        = schema.GroupKind{Group: Group, Kind: JWTAuthRoleKind}.String()
+	JWTAuthRoleKindAPIVersion   = JWTAuthRoleKind + "." + SchemeGroupVersion.String()
+	JWTAuthRoleGroupVersionKind = SchemeGroupVersion.WithKind(JWTAuthRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&JWTAuthRole{}, &JWTAuthRoleList{})
+}
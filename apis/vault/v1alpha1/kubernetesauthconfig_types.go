@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KubernetesAuthConfigParameters are the configurable fields of a
+// KubernetesAuthConfig.
+type KubernetesAuthConfigParameters struct {
+	// Mount is the path the kubernetes auth method is enabled at, without
+	// the auth/ prefix.
+	// +kubebuilder:default=kubernetes
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// KubernetesHost is the URL of the Kubernetes API server.
+	// +kubebuilder:validation:MinLength=1
+	KubernetesHost string `json:"kubernetesHost"`
+
+	// KubernetesCACertSecretRef selects the PEM encoded CA certificate of
+	// the Kubernetes API server.
+	// +optional
+	KubernetesCACertSecretRef *xpv1.SecretKeySelector `json:"kubernetesCACertSecretRef,omitempty"`
+
+	// TokenReviewerJWTSecretRef selects the service account token Vault uses
+	// to call the TokenReview API.
+	// +optional
+	TokenReviewerJWTSecretRef *xpv1.SecretKeySelector `json:"tokenReviewerJWTSecretRef,omitempty"`
+
+	// Issuer the service account tokens must be issued by.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// DisableLocalCAJWT stops Vault from using its own service account
+	// token and CA certificate when it runs in Kubernetes.
+	// +optional
+	DisableLocalCAJWT bool `json:"disableLocalCAJWT,omitempty"`
+}
+
+// KubernetesAuthConfigObservation are the observable fields of a
+// KubernetesAuthConfig.
+type KubernetesAuthConfigObservation struct {
+	// Path of the configuration in Vault.
+	Path string `json:"path,omitempty"`
+
+	// SecretsHash is the SHA-256 checksum of the CA certificate and reviewer
+	// token last written to Vault, which tells when the Secrets rotate.
+	SecretsHash string `json:"secretsHash,omitempty"`
+}
+
+// A KubernetesAuthConfigSpec defines the desired state of a
+// KubernetesAuthConfig.
+type KubernetesAuthConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KubernetesAuthConfigParameters `json:"forProvider"`
+}
+
+// A KubernetesAuthConfigStatus represents the observed state of a
+// KubernetesAuthConfig.
+type KubernetesAuthConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KubernetesAuthConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KubernetesAuthConfig configures how the kubernetes auth method reaches
+// the Kubernetes API server to verify service account tokens.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".spec.forProvider.kubernetesHost"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type KubernetesAuthConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubernetesAuthConfigSpec   `json:"spec"`
+	Status KubernetesAuthConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesAuthConfigList contains a list of KubernetesAuthConfig
+type KubernetesAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesAuthConfig `json:"items"`
+}
+
+// KubernetesAuthConfig type metadata.
+var (
+	KubernetesAuthConfigKind             = reflect.TypeOf(KubernetesAuthConfig{}).Name()
+	KubernetesAuthConfigGroupKind        = schema.GroupKind{Group: Group, Kind: KubernetesAuthConfigKind}.String()
+	KubernetesAuthConfigKindAPIVersion   = KubernetesAuthConfigKind + "." + SchemeGroupVersion.String()
+	KubernetesAuthConfigGroupVersionKind = SchemeGroupVersion.WithKind(KubernetesAuthConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KubernetesAuthConfig{}, &KubernetesAuthConfigList{})
+}
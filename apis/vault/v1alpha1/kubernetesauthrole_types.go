@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KubernetesAuthRoleParameters are the configurable fields of a
+// KubernetesAuthRole.
+type KubernetesAuthRoleParameters struct {
+	// Mount is the path the kubernetes auth method is enabled at, without
+	// the auth/ prefix.
+	// +kubebuilder:default=kubernetes
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// BoundServiceAccountNames lists the service accounts allowed to log in.
+	// "*" allows any of them.
+	// +kubebuilder:validation:MinItems=1
+	BoundServiceAccountNames []string `json:"boundServiceAccountNames"`
+
+	// BoundServiceAccountNamespaces lists the namespaces of the service
+	// accounts allowed to log in. "*" allows any of them.
+	// +kubebuilder:validation:MinItems=1
+	BoundServiceAccountNamespaces []string `json:"boundServiceAccountNamespaces"`
+
+	// Audience the service account tokens must be issued for.
+	// +optional
+	Audience string `json:"audience,omitempty"`
+
+	// AliasNameSource is the service account attribute the identity alias
+	// is named after.
+	// +kubebuilder:validation:Enum=serviceaccount_uid;serviceaccount_name
+	// +optional
+	AliasNameSource string `json:"aliasNameSource,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// KubernetesAuthRoleObservation are the observable fields of a
+// KubernetesAuthRole.
+type KubernetesAuthRoleObservation struct {
+	// Path of the role in Vault.
+	Path string `json:"path,omitempty"`
+
+	// TokenPolicies attached to the issued tokens, as Vault reports them.
+	TokenPolicies []string `json:"tokenPolicies,omitempty"`
+}
+
+// A KubernetesAuthRoleSpec defines the desired state of a KubernetesAuthRole.
+type KubernetesAuthRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KubernetesAuthRoleParameters `json:"forProvider"`
+}
+
+// A KubernetesAuthRoleStatus represents the observed state of a
+// KubernetesAuthRole.
+type KubernetesAuthRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KubernetesAuthRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KubernetesAuthRole lets Kubernetes service accounts log in to Vault
+// through the kubernetes auth method.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type KubernetesAuthRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubernetesAuthRoleSpec   `json:"spec"`
+	Status KubernetesAuthRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesAuthRoleList contains a list of KubernetesAuthRole
+type KubernetesAuthRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesAuthRole `json:"items"`
+}
+
+// KubernetesAuthRole type metadata.
+var (
+	KubernetesAuthRoleKind             = reflect.TypeOf(KubernetesAuthRole{}).Name()
+	KubernetesAuthRoleGroupKind        = schema.GroupKind{Group: Group, Kind: KubernetesAuthRoleKind}.String()
+	KubernetesAuthRoleKindAPIVersion   = KubernetesAuthRoleKind + "." + SchemeGroupVersion.String()
+	KubernetesAuthRoleGroupVersionKind = SchemeGroupVersion.WithKind(KubernetesAuthRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KubernetesAuthRole{}, &KubernetesAuthRoleList{})
+}
@@ -27,17 +27,62 @@ import (
 
 // PolicyParameters are the configurable fields of a Policy.
 type PolicyParameters struct {
-	Rules []Rule `json:"rules"`
+	// Rules of the policy, rendered into a single ACL document.
+	// +optional
+	Rules []Rule `json:"rules,omitempty"`
+
+	// Document is a raw HCL or JSON ACL policy, written to Vault as is. It is
+	// an alternative to Rules and cannot be combined with them.
+	// +optional
+	Document string `json:"document,omitempty"`
 }
 
+// A Rule grants capabilities on a path, mirroring a path stanza of a Vault
+// ACL policy.
 type Rule struct {
 	Path         string   `json:"path"`
 	Capabilities []string `json:"capabilities"`
+
+	// AllowedParameters whitelists the request parameters, and their values,
+	// accepted on this path. An empty list of values allows any value.
+	// +optional
+	AllowedParameters map[string][]string `json:"allowedParameters,omitempty"`
+
+	// DeniedParameters blacklists request parameters, and their values, on
+	// this path. An empty list of values denies any value.
+	// +optional
+	DeniedParameters map[string][]string `json:"deniedParameters,omitempty"`
+
+	// RequiredParameters lists the parameters every request on this path
+	// must carry.
+	// +optional
+	RequiredParameters []string `json:"requiredParameters,omitempty"`
+
+	// MinWrappingTTL is the minimum response wrapping TTL allowed on this
+	// path.
+	// +optional
+	MinWrappingTTL *metav1.Duration `json:"minWrappingTTL,omitempty"`
+
+	// MaxWrappingTTL is the maximum response wrapping TTL allowed on this
+	// path.
+	// +optional
+	MaxWrappingTTL *metav1.Duration `json:"maxWrappingTTL,omitempty"`
 }
 
 // PolicyObservation are the observable fields of a Policy.
 type PolicyObservation struct {
-	ObservableField string `json:"observableField,omitempty"`
+	// Name of the policy as stored in Vault.
+	Name string `json:"name,omitempty"`
+
+	// Document is the ACL document Vault held for the Policy on the last
+	// observation, rendered from the rules Vault reported.
+	Document string `json:"document,omitempty"`
+
+	// Hash is the SHA-256 checksum of Document.
+	Hash string `json:"hash,omitempty"`
+
+	// DriftedRules lists the rules found out of sync on the last observation.
+	DriftedRules []string `json:"driftedRules,omitempty"`
 }
 
 // A PolicySpec defines the desired state of a Policy.
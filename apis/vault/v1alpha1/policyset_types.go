@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PolicySetParameters are the configurable fields of a PolicySet.
+type PolicySetParameters struct {
+	// Selector selects the Policy fragments merged into the set.
+	Selector metav1.LabelSelector `json:"selector"`
+}
+
+// PolicySetObservation are the observable fields of a PolicySet.
+type PolicySetObservation struct {
+	// Name of the policy as stored in Vault.
+	Name string `json:"name,omitempty"`
+
+	// Fragments lists the Policy fragments merged into the set.
+	Fragments []string `json:"fragments,omitempty"`
+
+	// Document is the ACL document last applied to Vault.
+	Document string `json:"document,omitempty"`
+
+	// Hash is the SHA-256 checksum of Document.
+	Hash string `json:"hash,omitempty"`
+
+	// DriftedRules lists the rules found out of sync on the last observation.
+	DriftedRules []string `json:"driftedRules,omitempty"`
+}
+
+// A PolicySetSpec defines the desired state of a PolicySet.
+type PolicySetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicySetParameters `json:"forProvider"`
+}
+
+// A PolicySetStatus represents the observed state of a PolicySet.
+type PolicySetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicySetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PolicySet renders the Policy fragments it selects into a single Vault
+// policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type PolicySet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySetSpec   `json:"spec"`
+	Status PolicySetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicySetList contains a list of PolicySet
+type PolicySetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicySet `json:"items"`
+}
+
+// PolicySet type metadata.
+var (
+	PolicySetKind             = reflect.TypeOf(PolicySet{}).Name()
+	PolicySetGroupKind        = schema.GroupKind{Group: Group, Kind: PolicySetKind}.String()
+	PolicySetKindAPIVersion   = PolicySetKind + "." + SchemeGroupVersion.String()
+	PolicySetGroupVersionKind = SchemeGroupVersion.WithKind(PolicySetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PolicySet{}, &PolicySetList{})
+}
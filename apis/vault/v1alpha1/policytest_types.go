@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Expected outcomes of a PolicyAssertion.
+const (
+	AssertionAllowed = "Allowed"
+	AssertionDenied  = "Denied"
+)
+
+// PolicyTestParameters are the configurable fields of a PolicyTest.
+type PolicyTestParameters struct {
+	// Policies evaluated together, by their name in Vault, as if they were
+	// all attached to the same token.
+	Policies []string `json:"policies"`
+
+	// Assertions checked against the policies.
+	Assertions []PolicyAssertion `json:"assertions"`
+}
+
+// A PolicyAssertion expects a capability on a path to be allowed or denied.
+type PolicyAssertion struct {
+	Path       string `json:"path"`
+	Capability string `json:"capability"`
+
+	// +kubebuilder:validation:Enum=Allowed;Denied
+	Expect string `json:"expect"`
+}
+
+// A PolicyAssertionResult is the outcome of a PolicyAssertion.
+type PolicyAssertionResult struct {
+	Path       string `json:"path"`
+	Capability string `json:"capability"`
+	Expect     string `json:"expect"`
+
+	// Result is Allowed or Denied, as evaluated by Vault's ACL engine.
+	Result string `json:"result"`
+
+	// Capabilities granted on the path by the policies.
+	Capabilities []string `json:"capabilities,omitempty"`
+
+	Passed bool `json:"passed"`
+}
+
+// PolicyTestObservation are the observable fields of a PolicyTest.
+type PolicyTestObservation struct {
+	Results []PolicyAssertionResult `json:"results,omitempty"`
+
+	// Failed counts the assertions that do not hold. Every assertion fails
+	// while one of the policies does not exist.
+	Failed int `json:"failed"`
+}
+
+// A PolicyTestSpec defines the desired state of a PolicyTest.
+type PolicyTestSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyTestParameters `json:"forProvider"`
+}
+
+// A PolicyTestStatus represents the observed state of a PolicyTest.
+type PolicyTestStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyTestObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PolicyTest evaluates Vault policies offline and checks which capabilities
+// they grant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAILED",type="integer",JSONPath=".status.atProvider.failed"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type PolicyTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyTestSpec   `json:"spec"`
+	Status PolicyTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyTestList contains a list of PolicyTest
+type PolicyTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyTest `json:"items"`
+}
+
+// PolicyTest type metadata.
+var (
+	PolicyTestKind             = reflect.TypeOf(PolicyTest{}).Name()
+	PolicyTestGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyTestKind}.String()
+	PolicyTestKindAPIVersion   = PolicyTestKind + "." + SchemeGroupVersion.String()
+	PolicyTestGroupVersionKind = SchemeGroupVersion.WithKind(PolicyTestKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PolicyTest{}, &PolicyTestList{})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// resolvePolicies resolves the Policies referenced or selected by mg into
+// their names in Vault, reporting errors against field.
+func resolvePolicies(ctx context.Context, c client.Reader, mg resource.Managed, field string, names *[]string, refs *[]xpv1.Reference, selector *xpv1.Selector) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: *names,
+		References:    *refs,
+		Selector:      selector,
+		To:            reference.To{Managed: &Policy{}, List: &PolicyList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, field)
+	}
+
+	*names = rsp.ResolvedValues
+	*refs = rsp.ResolvedReferences
+
+	return nil
+}
+
+// resolveTokenPolicies resolves the Policies referenced or selected by the
+// token parameters of mg into their names in Vault.
+func resolveTokenPolicies(ctx context.Context, c client.Reader, mg resource.Managed, params *TokenParameters) error {
+	return resolvePolicies(ctx, c, mg, "spec.forProvider.tokenPolicies", &params.TokenPolicies, &params.TokenPolicyRefs, params.TokenPolicySelector)
+}
+
+// ResolveReferences of this KubernetesAuthRole.
+func (mg *KubernetesAuthRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return resolveTokenPolicies(ctx, c, mg, &mg.Spec.ForProvider.TokenParameters)
+}
+
+// ResolveReferences of this AppRoleRole.
+func (mg *AppRoleRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return resolveTokenPolicies(ctx, c, mg, &mg.Spec.ForProvider.TokenParameters)
+}
+
+// ResolveReferences of this JWTAuthRole.
+func (mg *JWTAuthRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return resolveTokenPolicies(ctx, c, mg, &mg.Spec.ForProvider.TokenParameters)
+}
+
+// ResolveReferences of this UserpassUser.
+func (mg *UserpassUser) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return resolveTokenPolicies(ctx, c, mg, &mg.Spec.ForProvider.TokenParameters)
+}
+
+// ResolveReferences of this CertAuthRole.
+func (mg *CertAuthRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return resolveTokenPolicies(ctx, c, mg, &mg.Spec.ForProvider.TokenParameters)
+}
+
+// ResolveReferences of this TokenRole.
+func (mg *TokenRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.ForProvider
+	return resolvePolicies(ctx, c, mg, "spec.forProvider.allowedPolicies", &p.AllowedPolicies, &p.AllowedPolicyRefs, p.AllowedPolicySelector)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TokenRoleParameters are the configurable fields of a TokenRole.
+type TokenRoleParameters struct {
+	// AllowedPolicies lists the policies the tokens created from the role
+	// may carry.
+	// +optional
+	AllowedPolicies []string `json:"allowedPolicies,omitempty"`
+
+	// AllowedPolicyRefs references the Policies the tokens created from the
+	// role may carry.
+	// +optional
+	AllowedPolicyRefs []xpv1.Reference `json:"allowedPolicyRefs,omitempty"`
+
+	// AllowedPolicySelector selects the Policies the tokens created from the
+	// role may carry.
+	// +optional
+	AllowedPolicySelector *xpv1.Selector `json:"allowedPolicySelector,omitempty"`
+
+	// DisallowedPolicies lists the policies the tokens created from the role
+	// must not carry.
+	// +optional
+	DisallowedPolicies []string `json:"disallowedPolicies,omitempty"`
+
+	// AllowedPoliciesGlob lists the glob patterns of the policies the tokens
+	// created from the role may carry.
+	// +optional
+	AllowedPoliciesGlob []string `json:"allowedPoliciesGlob,omitempty"`
+
+	// DisallowedPoliciesGlob lists the glob patterns of the policies the
+	// tokens created from the role must not carry.
+	// +optional
+	DisallowedPoliciesGlob []string `json:"disallowedPoliciesGlob,omitempty"`
+
+	// Orphan makes the tokens created from the role orphans, outliving the
+	// token that created them.
+	// +optional
+	Orphan bool `json:"orphan,omitempty"`
+
+	// Renewable tells whether the tokens created from the role can be
+	// renewed.
+	// +kubebuilder:default=true
+	// +optional
+	Renewable *bool `json:"renewable,omitempty"`
+
+	// TokenPeriod makes the tokens created from the role periodic, renewable
+	// for that long indefinitely.
+	// +optional
+	TokenPeriod *metav1.Duration `json:"tokenPeriod,omitempty"`
+
+	// TokenExplicitMaxTTL is the hard limit of the lifetime of the tokens
+	// created from the role, renewals included.
+	// +optional
+	TokenExplicitMaxTTL *metav1.Duration `json:"tokenExplicitMaxTTL,omitempty"`
+
+	// TokenType is the type of the tokens created from the role.
+	// +kubebuilder:validation:Enum=default;default-service;default-batch;service;batch
+	// +optional
+	TokenType string `json:"tokenType,omitempty"`
+
+	// TokenBoundCIDRs lists the CIDR blocks the tokens created from the role
+	// can be used from.
+	// +optional
+	TokenBoundCIDRs []string `json:"tokenBoundCIDRs,omitempty"`
+}
+
+// TokenRoleObservation are the observable fields of a TokenRole.
+type TokenRoleObservation struct {
+	// Path of the role in Vault.
+	Path string `json:"path,omitempty"`
+}
+
+// A TokenRoleSpec defines the desired state of a TokenRole.
+type TokenRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TokenRoleParameters `json:"forProvider"`
+}
+
+// A TokenRoleStatus represents the observed state of a TokenRole.
+type TokenRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TokenRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TokenRole manages a role of the token auth method, which tokens can be
+// created from.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type TokenRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TokenRoleSpec   `json:"spec"`
+	Status TokenRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TokenRoleList contains a list of TokenRole
+type TokenRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TokenRole `json:"items"`
+}
+
+// TokenRole type metadata.
+var (
+	TokenRoleKind             = reflect.TypeOf(TokenRole{}).Name()
+	TokenRoleGroupKind        = schema.GroupKind{Group: Group, Kind: TokenRoleKind}.String()
+	TokenRoleKindAPIVersion   = TokenRoleKind + "." + SchemeGroupVersion.String()
+	TokenRoleGroupVersionKind = SchemeGroupVersion.WithKind(TokenRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TokenRole{}, &TokenRoleList{})
+}
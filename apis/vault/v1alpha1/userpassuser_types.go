@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection details published by a UserpassUser.
+const (
+	ConnectionKeyUsername = "username"
+	ConnectionKeyPassword = "password"
+)
+
+// AnnotationKeyRotatePassword rotates the password of a UserpassUser whenever
+// its value changes, e.g. to the current date.
+const AnnotationKeyRotatePassword = "vault.secret.crossplane.io/rotate-password"
+
+// UserpassUserParameters are the configurable fields of a UserpassUser.
+type UserpassUserParameters struct {
+	// Mount is the path the userpass auth method is enabled at, without the
+	// auth/ prefix.
+	// +kubebuilder:default=userpass
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// PasswordSecretRef selects the password of the user, which must not be
+	// empty. The provider generates one when unset, and publishes it as a
+	// connection detail.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// PasswordLength is the length of the passwords the provider generates.
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=32
+	// +optional
+	PasswordLength int `json:"passwordLength,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// UserpassUserObservation are the observable fields of a UserpassUser.
+type UserpassUserObservation struct {
+	// Path of the user in Vault.
+	Path string `json:"path,omitempty"`
+
+	// TokenPolicies attached to the issued tokens, as Vault reports them.
+	TokenPolicies []string `json:"tokenPolicies,omitempty"`
+
+	// PasswordLastSetTime is when the provider last wrote the password to
+	// Vault and published it.
+	PasswordLastSetTime *metav1.Time `json:"passwordLastSetTime,omitempty"`
+
+	// PasswordSecretVersion is the resourceVersion of the referenced Secret
+	// the password was last read from, which tells when the Secret changes.
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+
+	// PasswordRotation is the value of the rotate-password annotation the
+	// password was last rotated for.
+	PasswordRotation string `json:"passwordRotation,omitempty"`
+}
+
+// A UserpassUserSpec defines the desired state of a UserpassUser.
+// +kubebuilder:validation:XValidation:rule="has(self.forProvider.passwordSecretRef) || has(self.writeConnectionSecretToRef) || has(self.publishConnectionDetailsTo)",message="a generated password must be published, so writeConnectionSecretToRef or publishConnectionDetailsTo must be set when passwordSecretRef is not"
+type UserpassUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserpassUserParameters `json:"forProvider"`
+}
+
+// A UserpassUserStatus represents the observed state of a UserpassUser.
+type UserpassUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserpassUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserpassUser manages a user of the userpass auth method and publishes its
+// username and password.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,secret}
+type UserpassUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserpassUserSpec   `json:"spec"`
+	Status UserpassUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserpassUserList contains a list of UserpassUser
+type UserpassUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserpassUser `json:"items"`
+}
+
+// UserpassUser type metadata.
+var (
+	UserpassUserKind             = reflect.TypeOf(UserpassUser{}).Name()
+	UserpassUserGroupKind        = schema.GroupKind{Group: Group, Kind: UserpassUserKind}.String()
+	UserpassUserKindAPIVersion   = UserpassUserKind + "." + SchemeGroupVersion.String()
+	UserpassUserGroupVersionKind = SchemeGroupVersion.WithKind(UserpassUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&UserpassUser{}, &UserpassUserList{})
+}
@@ -22,9 +22,491 @@ limitations under the License.
 package v1alpha1
 
 import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleRole) DeepCopyInto(out *AppRoleRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleRole.
+func (in *AppRoleRole) DeepCopy() *AppRoleRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRoleRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleRoleList) DeepCopyInto(out *AppRoleRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AppRoleRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleRoleList.
+func (in *AppRoleRoleList) DeepCopy() *AppRoleRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRoleRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleRoleObservation) DeepCopyInto(out *AppRoleRoleObservation) {
+	*out = *in
+	if in.SecretIDExpiration != nil {
+		in, out := &in.SecretIDExpiration, &out.SecretIDExpiration
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleRoleObservation.
+func (in *AppRoleRoleObservation) DeepCopy() *AppRoleRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleRoleParameters) DeepCopyInto(out *AppRoleRoleParameters) {
+	*out = *in
+	if in.SecretIDTTL != nil {
+		in, out := &in.SecretIDTTL, &out.SecretIDTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SecretIDNumUses != nil {
+		in, out := &in.SecretIDNumUses, &out.SecretIDNumUses
+		*out = new(int)
+		**out = **in
+	}
+	if in.SecretIDBoundCIDRs != nil {
+		in, out := &in.SecretIDBoundCIDRs, &out.SecretIDBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretIDRotateBefore != nil {
+		in, out := &in.SecretIDRotateBefore, &out.SecretIDRotateBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleRoleParameters.
+func (in *AppRoleRoleParameters) DeepCopy() *AppRoleRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleRoleSpec) DeepCopyInto(out *AppRoleRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleRoleSpec.
+func (in *AppRoleRoleSpec) DeepCopy() *AppRoleRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleRoleStatus) DeepCopyInto(out *AppRoleRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleRoleStatus.
+func (in *AppRoleRoleStatus) DeepCopy() *AppRoleRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthBackend) DeepCopyInto(out *AuthBackend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthBackend.
+func (in *AuthBackend) DeepCopy() *AuthBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthBackend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthBackendList) DeepCopyInto(out *AuthBackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthBackendList.
+func (in *AuthBackendList) DeepCopy() *AuthBackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthBackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthBackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthBackendObservation) DeepCopyInto(out *AuthBackendObservation) {
+	*out = *in
+	if in.DefaultLeaseTTL != nil {
+		in, out := &in.DefaultLeaseTTL, &out.DefaultLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxLeaseTTL != nil {
+		in, out := &in.MaxLeaseTTL, &out.MaxLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthBackendObservation.
+func (in *AuthBackendObservation) DeepCopy() *AuthBackendObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthBackendObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthBackendParameters) DeepCopyInto(out *AuthBackendParameters) {
+	*out = *in
+	if in.DefaultLeaseTTL != nil {
+		in, out := &in.DefaultLeaseTTL, &out.DefaultLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxLeaseTTL != nil {
+		in, out := &in.MaxLeaseTTL, &out.MaxLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AuditNonHMACRequestKeys != nil {
+		in, out := &in.AuditNonHMACRequestKeys, &out.AuditNonHMACRequestKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditNonHMACResponseKeys != nil {
+		in, out := &in.AuditNonHMACResponseKeys, &out.AuditNonHMACResponseKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PassthroughRequestHeaders != nil {
+		in, out := &in.PassthroughRequestHeaders, &out.PassthroughRequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedResponseHeaders != nil {
+		in, out := &in.AllowedResponseHeaders, &out.AllowedResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthBackendParameters.
+func (in *AuthBackendParameters) DeepCopy() *AuthBackendParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthBackendParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthBackendSpec) DeepCopyInto(out *AuthBackendSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthBackendSpec.
+func (in *AuthBackendSpec) DeepCopy() *AuthBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthBackendStatus) DeepCopyInto(out *AuthBackendStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthBackendStatus.
+func (in *AuthBackendStatus) DeepCopy() *AuthBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertAuthRole) DeepCopyInto(out *CertAuthRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthRole.
+func (in *CertAuthRole) DeepCopy() *CertAuthRole {
+	if in == nil {
+		return nil
+	}
+	out := new(CertAuthRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertAuthRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertAuthRoleList) DeepCopyInto(out *CertAuthRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertAuthRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthRoleList.
+func (in *CertAuthRoleList) DeepCopy() *CertAuthRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertAuthRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertAuthRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertAuthRoleObservation) DeepCopyInto(out *CertAuthRoleObservation) {
+	*out = *in
+	if in.TokenPolicies != nil {
+		in, out := &in.TokenPolicies, &out.TokenPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthRoleObservation.
+func (in *CertAuthRoleObservation) DeepCopy() *CertAuthRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertAuthRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertAuthRoleParameters) DeepCopyInto(out *CertAuthRoleParameters) {
+	*out = *in
+	if in.CertificateSecretRef != nil {
+		in, out := &in.CertificateSecretRef, &out.CertificateSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CertificateConfigMapRef != nil {
+		in, out := &in.CertificateConfigMapRef, &out.CertificateConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.AllowedCommonNames != nil {
+		in, out := &in.AllowedCommonNames, &out.AllowedCommonNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedDNSSANs != nil {
+		in, out := &in.AllowedDNSSANs, &out.AllowedDNSSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOrganizationalUnits != nil {
+		in, out := &in.AllowedOrganizationalUnits, &out.AllowedOrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthRoleParameters.
+func (in *CertAuthRoleParameters) DeepCopy() *CertAuthRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CertAuthRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertAuthRoleSpec) DeepCopyInto(out *CertAuthRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthRoleSpec.
+func (in *CertAuthRoleSpec) DeepCopy() *CertAuthRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertAuthRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertAuthRoleStatus) DeepCopyInto(out *CertAuthRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertAuthRoleStatus.
+func (in *CertAuthRoleStatus) DeepCopy() *CertAuthRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertAuthRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Engine) DeepCopyInto(out *Engine) {
 	*out = *in
@@ -34,18 +516,1363 @@ func (in *Engine) DeepCopyInto(out *Engine) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Engine.
-func (in *Engine) DeepCopy() *Engine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Engine.
+func (in *Engine) DeepCopy() *Engine {
+	if in == nil {
+		return nil
+	}
+	out := new(Engine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Engine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineList) DeepCopyInto(out *EngineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Engine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineList.
+func (in *EngineList) DeepCopy() *EngineList {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EngineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineObservation) DeepCopyInto(out *EngineObservation) {
+	*out = *in
+	if in.Remount != nil {
+		in, out := &in.Remount, &out.Remount
+		*out = new(EngineRemount)
+		**out = **in
+	}
+	if in.DefaultLeaseTTL != nil {
+		in, out := &in.DefaultLeaseTTL, &out.DefaultLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxLeaseTTL != nil {
+		in, out := &in.MaxLeaseTTL, &out.MaxLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineObservation.
+func (in *EngineObservation) DeepCopy() *EngineObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineParameters) DeepCopyInto(out *EngineParameters) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KV != nil {
+		in, out := &in.KV, &out.KV
+		*out = new(KVOptions)
+		**out = **in
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginOptions)
+		**out = **in
+	}
+	if in.DefaultLeaseTTL != nil {
+		in, out := &in.DefaultLeaseTTL, &out.DefaultLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxLeaseTTL != nil {
+		in, out := &in.MaxLeaseTTL, &out.MaxLeaseTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AuditNonHMACRequestKeys != nil {
+		in, out := &in.AuditNonHMACRequestKeys, &out.AuditNonHMACRequestKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PassthroughRequestHeaders != nil {
+		in, out := &in.PassthroughRequestHeaders, &out.PassthroughRequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedResponseHeaders != nil {
+		in, out := &in.AllowedResponseHeaders, &out.AllowedResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KVConfig != nil {
+		in, out := &in.KVConfig, &out.KVConfig
+		*out = new(KVConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineParameters.
+func (in *EngineParameters) DeepCopy() *EngineParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineRemount) DeepCopyInto(out *EngineRemount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineRemount.
+func (in *EngineRemount) DeepCopy() *EngineRemount {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineRemount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineSpec) DeepCopyInto(out *EngineSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineSpec.
+func (in *EngineSpec) DeepCopy() *EngineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineStatus) DeepCopyInto(out *EngineStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineStatus.
+func (in *EngineStatus) DeepCopy() *EngineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthConfig) DeepCopyInto(out *JWTAuthConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthConfig.
+func (in *JWTAuthConfig) DeepCopy() *JWTAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTAuthConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthConfigList) DeepCopyInto(out *JWTAuthConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JWTAuthConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthConfigList.
+func (in *JWTAuthConfigList) DeepCopy() *JWTAuthConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTAuthConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthConfigObservation) DeepCopyInto(out *JWTAuthConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthConfigObservation.
+func (in *JWTAuthConfigObservation) DeepCopy() *JWTAuthConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthConfigParameters) DeepCopyInto(out *JWTAuthConfigParameters) {
+	*out = *in
+	if in.OIDCClientSecretSecretRef != nil {
+		in, out := &in.OIDCClientSecretSecretRef, &out.OIDCClientSecretSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.JWTValidationPubkeys != nil {
+		in, out := &in.JWTValidationPubkeys, &out.JWTValidationPubkeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JWTSupportedAlgs != nil {
+		in, out := &in.JWTSupportedAlgs, &out.JWTSupportedAlgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthConfigParameters.
+func (in *JWTAuthConfigParameters) DeepCopy() *JWTAuthConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthConfigSpec) DeepCopyInto(out *JWTAuthConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthConfigSpec.
+func (in *JWTAuthConfigSpec) DeepCopy() *JWTAuthConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthConfigStatus) DeepCopyInto(out *JWTAuthConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthConfigStatus.
+func (in *JWTAuthConfigStatus) DeepCopy() *JWTAuthConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthRole) DeepCopyInto(out *JWTAuthRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthRole.
+func (in *JWTAuthRole) DeepCopy() *JWTAuthRole {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTAuthRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthRoleList) DeepCopyInto(out *JWTAuthRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JWTAuthRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthRoleList.
+func (in *JWTAuthRoleList) DeepCopy() *JWTAuthRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTAuthRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthRoleObservation) DeepCopyInto(out *JWTAuthRoleObservation) {
+	*out = *in
+	if in.TokenPolicies != nil {
+		in, out := &in.TokenPolicies, &out.TokenPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthRoleObservation.
+func (in *JWTAuthRoleObservation) DeepCopy() *JWTAuthRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthRoleParameters) DeepCopyInto(out *JWTAuthRoleParameters) {
+	*out = *in
+	if in.BoundAudiences != nil {
+		in, out := &in.BoundAudiences, &out.BoundAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundClaims != nil {
+		in, out := &in.BoundClaims, &out.BoundClaims
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ClaimMappings != nil {
+		in, out := &in.ClaimMappings, &out.ClaimMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AllowedRedirectURIs != nil {
+		in, out := &in.AllowedRedirectURIs, &out.AllowedRedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OIDCScopes != nil {
+		in, out := &in.OIDCScopes, &out.OIDCScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthRoleParameters.
+func (in *JWTAuthRoleParameters) DeepCopy() *JWTAuthRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthRoleSpec) DeepCopyInto(out *JWTAuthRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthRoleSpec.
+func (in *JWTAuthRoleSpec) DeepCopy() *JWTAuthRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthRoleStatus) DeepCopyInto(out *JWTAuthRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthRoleStatus.
+func (in *JWTAuthRoleStatus) DeepCopy() *JWTAuthRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVConfig) DeepCopyInto(out *KVConfig) {
+	*out = *in
+	if in.MaxVersions != nil {
+		in, out := &in.MaxVersions, &out.MaxVersions
+		*out = new(int)
+		**out = **in
+	}
+	if in.CASRequired != nil {
+		in, out := &in.CASRequired, &out.CASRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteVersionAfter != nil {
+		in, out := &in.DeleteVersionAfter, &out.DeleteVersionAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVConfig.
+func (in *KVConfig) DeepCopy() *KVConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KVConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVOptions) DeepCopyInto(out *KVOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVOptions.
+func (in *KVOptions) DeepCopy() *KVOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KVOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthConfig) DeepCopyInto(out *KubernetesAuthConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthConfig.
+func (in *KubernetesAuthConfig) DeepCopy() *KubernetesAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesAuthConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthConfigList) DeepCopyInto(out *KubernetesAuthConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesAuthConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthConfigList.
+func (in *KubernetesAuthConfigList) DeepCopy() *KubernetesAuthConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesAuthConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthConfigObservation) DeepCopyInto(out *KubernetesAuthConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthConfigObservation.
+func (in *KubernetesAuthConfigObservation) DeepCopy() *KubernetesAuthConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthConfigParameters) DeepCopyInto(out *KubernetesAuthConfigParameters) {
+	*out = *in
+	if in.KubernetesCACertSecretRef != nil {
+		in, out := &in.KubernetesCACertSecretRef, &out.KubernetesCACertSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.TokenReviewerJWTSecretRef != nil {
+		in, out := &in.TokenReviewerJWTSecretRef, &out.TokenReviewerJWTSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthConfigParameters.
+func (in *KubernetesAuthConfigParameters) DeepCopy() *KubernetesAuthConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthConfigSpec) DeepCopyInto(out *KubernetesAuthConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthConfigSpec.
+func (in *KubernetesAuthConfigSpec) DeepCopy() *KubernetesAuthConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthConfigStatus) DeepCopyInto(out *KubernetesAuthConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthConfigStatus.
+func (in *KubernetesAuthConfigStatus) DeepCopy() *KubernetesAuthConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthRole) DeepCopyInto(out *KubernetesAuthRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthRole.
+func (in *KubernetesAuthRole) DeepCopy() *KubernetesAuthRole {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesAuthRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthRoleList) DeepCopyInto(out *KubernetesAuthRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesAuthRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthRoleList.
+func (in *KubernetesAuthRoleList) DeepCopy() *KubernetesAuthRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesAuthRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthRoleObservation) DeepCopyInto(out *KubernetesAuthRoleObservation) {
+	*out = *in
+	if in.TokenPolicies != nil {
+		in, out := &in.TokenPolicies, &out.TokenPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthRoleObservation.
+func (in *KubernetesAuthRoleObservation) DeepCopy() *KubernetesAuthRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthRoleParameters) DeepCopyInto(out *KubernetesAuthRoleParameters) {
+	*out = *in
+	if in.BoundServiceAccountNames != nil {
+		in, out := &in.BoundServiceAccountNames, &out.BoundServiceAccountNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundServiceAccountNamespaces != nil {
+		in, out := &in.BoundServiceAccountNamespaces, &out.BoundServiceAccountNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthRoleParameters.
+func (in *KubernetesAuthRoleParameters) DeepCopy() *KubernetesAuthRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthRoleSpec) DeepCopyInto(out *KubernetesAuthRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthRoleSpec.
+func (in *KubernetesAuthRoleSpec) DeepCopy() *KubernetesAuthRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthRoleStatus) DeepCopyInto(out *KubernetesAuthRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthRoleStatus.
+func (in *KubernetesAuthRoleStatus) DeepCopy() *KubernetesAuthRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginOptions) DeepCopyInto(out *PluginOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginOptions.
+func (in *PluginOptions) DeepCopy() *PluginOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyAssertion) DeepCopyInto(out *PolicyAssertion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyAssertion.
+func (in *PolicyAssertion) DeepCopy() *PolicyAssertion {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyAssertion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyAssertionResult) DeepCopyInto(out *PolicyAssertionResult) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyAssertionResult.
+func (in *PolicyAssertionResult) DeepCopy() *PolicyAssertionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyAssertionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
+	*out = *in
+	if in.DriftedRules != nil {
+		in, out := &in.DriftedRules, &out.DriftedRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
+func (in *PolicyObservation) DeepCopy() *PolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
+func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySet) DeepCopyInto(out *PolicySet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySet.
+func (in *PolicySet) DeepCopy() *PolicySet {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicySet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetList) DeepCopyInto(out *PolicySetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PolicySet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetList.
+func (in *PolicySetList) DeepCopy() *PolicySetList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicySetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetObservation) DeepCopyInto(out *PolicySetObservation) {
+	*out = *in
+	if in.Fragments != nil {
+		in, out := &in.Fragments, &out.Fragments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftedRules != nil {
+		in, out := &in.DriftedRules, &out.DriftedRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetObservation.
+func (in *PolicySetObservation) DeepCopy() *PolicySetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetParameters) DeepCopyInto(out *PolicySetParameters) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetParameters.
+func (in *PolicySetParameters) DeepCopy() *PolicySetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetSpec) DeepCopyInto(out *PolicySetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetSpec.
+func (in *PolicySetSpec) DeepCopy() *PolicySetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetStatus) DeepCopyInto(out *PolicySetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetStatus.
+func (in *PolicySetStatus) DeepCopy() *PolicySetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTest) DeepCopyInto(out *PolicyTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTest.
+func (in *PolicyTest) DeepCopy() *PolicyTest {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyTest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTestList) DeepCopyInto(out *PolicyTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PolicyTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTestList.
+func (in *PolicyTestList) DeepCopy() *PolicyTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTestObservation) DeepCopyInto(out *PolicyTestObservation) {
+	*out = *in
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]PolicyAssertionResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTestObservation.
+func (in *PolicyTestObservation) DeepCopy() *PolicyTestObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTestObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTestParameters) DeepCopyInto(out *PolicyTestParameters) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Assertions != nil {
+		in, out := &in.Assertions, &out.Assertions
+		*out = make([]PolicyAssertion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTestParameters.
+func (in *PolicyTestParameters) DeepCopy() *PolicyTestParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTestParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTestSpec) DeepCopyInto(out *PolicyTestSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTestSpec.
+func (in *PolicyTestSpec) DeepCopy() *PolicyTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTestStatus) DeepCopyInto(out *PolicyTestStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTestStatus.
+func (in *PolicyTestStatus) DeepCopy() *PolicyTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedParameters != nil {
+		in, out := &in.AllowedParameters, &out.AllowedParameters
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.DeniedParameters != nil {
+		in, out := &in.DeniedParameters, &out.DeniedParameters
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.RequiredParameters != nil {
+		in, out := &in.RequiredParameters, &out.RequiredParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinWrappingTTL != nil {
+		in, out := &in.MinWrappingTTL, &out.MinWrappingTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxWrappingTTL != nil {
+		in, out := &in.MaxWrappingTTL, &out.MaxWrappingTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretPath) DeepCopyInto(out *SecretPath) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPath.
+func (in *SecretPath) DeepCopy() *SecretPath {
 	if in == nil {
 		return nil
 	}
-	out := new(Engine)
+	out := new(SecretPath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Engine) DeepCopyObject() runtime.Object {
+func (in *SecretPath) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -53,31 +1880,31 @@ func (in *Engine) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineList) DeepCopyInto(out *EngineList) {
+func (in *SecretPathList) DeepCopyInto(out *SecretPathList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Engine, len(*in))
+		*out = make([]SecretPath, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineList.
-func (in *EngineList) DeepCopy() *EngineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathList.
+func (in *SecretPathList) DeepCopy() *SecretPathList {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineList)
+	out := new(SecretPathList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EngineList) DeepCopyObject() runtime.Object {
+func (in *SecretPathList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -85,78 +1912,123 @@ func (in *EngineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineObservation) DeepCopyInto(out *EngineObservation) {
+func (in *SecretPathObservation) DeepCopyInto(out *SecretPathObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineObservation.
-func (in *EngineObservation) DeepCopy() *EngineObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathObservation.
+func (in *SecretPathObservation) DeepCopy() *SecretPathObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineObservation)
+	out := new(SecretPathObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineParameters) DeepCopyInto(out *EngineParameters) {
+func (in *SecretPathParameters) DeepCopyInto(out *SecretPathParameters) {
 	*out = *in
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineParameters.
-func (in *EngineParameters) DeepCopy() *EngineParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathParameters.
+func (in *SecretPathParameters) DeepCopy() *SecretPathParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineParameters)
+	out := new(SecretPathParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineSpec) DeepCopyInto(out *EngineSpec) {
+func (in *SecretPathSpec) DeepCopyInto(out *SecretPathSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	out.ForProvider = in.ForProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineSpec.
-func (in *EngineSpec) DeepCopy() *EngineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathSpec.
+func (in *SecretPathSpec) DeepCopy() *SecretPathSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineSpec)
+	out := new(SecretPathSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EngineStatus) DeepCopyInto(out *EngineStatus) {
+func (in *SecretPathStatus) DeepCopyInto(out *SecretPathStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineStatus.
-func (in *EngineStatus) DeepCopy() *EngineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathStatus.
+func (in *SecretPathStatus) DeepCopy() *SecretPathStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EngineStatus)
+	out := new(SecretPathStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Policy) DeepCopyInto(out *Policy) {
+func (in *TokenParameters) DeepCopyInto(out *TokenParameters) {
+	*out = *in
+	if in.TokenPolicies != nil {
+		in, out := &in.TokenPolicies, &out.TokenPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenPolicyRefs != nil {
+		in, out := &in.TokenPolicyRefs, &out.TokenPolicyRefs
+		*out = make([]commonv1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TokenPolicySelector != nil {
+		in, out := &in.TokenPolicySelector, &out.TokenPolicySelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenTTL != nil {
+		in, out := &in.TokenTTL, &out.TokenTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TokenMaxTTL != nil {
+		in, out := &in.TokenMaxTTL, &out.TokenMaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TokenPeriod != nil {
+		in, out := &in.TokenPeriod, &out.TokenPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenParameters.
+func (in *TokenParameters) DeepCopy() *TokenParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRole) DeepCopyInto(out *TokenRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -164,18 +2036,18 @@ func (in *Policy) DeepCopyInto(out *Policy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
-func (in *Policy) DeepCopy() *Policy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRole.
+func (in *TokenRole) DeepCopy() *TokenRole {
 	if in == nil {
 		return nil
 	}
-	out := new(Policy)
+	out := new(TokenRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Policy) DeepCopyObject() runtime.Object {
+func (in *TokenRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -183,31 +2055,31 @@ func (in *Policy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+func (in *TokenRoleList) DeepCopyInto(out *TokenRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Policy, len(*in))
+		*out = make([]TokenRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
-func (in *PolicyList) DeepCopy() *PolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleList.
+func (in *TokenRoleList) DeepCopy() *TokenRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyList)
+	out := new(TokenRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PolicyList) DeepCopyObject() runtime.Object {
+func (in *TokenRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -215,98 +2087,123 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
+func (in *TokenRoleObservation) DeepCopyInto(out *TokenRoleObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
-func (in *PolicyObservation) DeepCopy() *PolicyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleObservation.
+func (in *TokenRoleObservation) DeepCopy() *TokenRoleObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyObservation)
+	out := new(TokenRoleObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+func (in *TokenRoleParameters) DeepCopyInto(out *TokenRoleParameters) {
 	*out = *in
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = make([]Rule, len(*in))
+	if in.AllowedPolicies != nil {
+		in, out := &in.AllowedPolicies, &out.AllowedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedPolicyRefs != nil {
+		in, out := &in.AllowedPolicyRefs, &out.AllowedPolicyRefs
+		*out = make([]commonv1.Reference, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AllowedPolicySelector != nil {
+		in, out := &in.AllowedPolicySelector, &out.AllowedPolicySelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisallowedPolicies != nil {
+		in, out := &in.DisallowedPolicies, &out.DisallowedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedPoliciesGlob != nil {
+		in, out := &in.AllowedPoliciesGlob, &out.AllowedPoliciesGlob
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisallowedPoliciesGlob != nil {
+		in, out := &in.DisallowedPoliciesGlob, &out.DisallowedPoliciesGlob
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Renewable != nil {
+		in, out := &in.Renewable, &out.Renewable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TokenPeriod != nil {
+		in, out := &in.TokenPeriod, &out.TokenPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TokenExplicitMaxTTL != nil {
+		in, out := &in.TokenExplicitMaxTTL, &out.TokenExplicitMaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
-func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleParameters.
+func (in *TokenRoleParameters) DeepCopy() *TokenRoleParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyParameters)
+	out := new(TokenRoleParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+func (in *TokenRoleSpec) DeepCopyInto(out *TokenRoleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
-func (in *PolicySpec) DeepCopy() *PolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleSpec.
+func (in *TokenRoleSpec) DeepCopy() *TokenRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicySpec)
+	out := new(TokenRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+func (in *TokenRoleStatus) DeepCopyInto(out *TokenRoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
-func (in *PolicyStatus) DeepCopy() *PolicyStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(PolicyStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Rule) DeepCopyInto(out *Rule) {
-	*out = *in
-	if in.Capabilities != nil {
-		in, out := &in.Capabilities, &out.Capabilities
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
-func (in *Rule) DeepCopy() *Rule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleStatus.
+func (in *TokenRoleStatus) DeepCopy() *TokenRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Rule)
+	out := new(TokenRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretPath) DeepCopyInto(out *SecretPath) {
+func (in *UserpassUser) DeepCopyInto(out *UserpassUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -314,18 +2211,18 @@ func (in *SecretPath) DeepCopyInto(out *SecretPath) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPath.
-func (in *SecretPath) DeepCopy() *SecretPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUser.
+func (in *UserpassUser) DeepCopy() *UserpassUser {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretPath)
+	out := new(UserpassUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretPath) DeepCopyObject() runtime.Object {
+func (in *UserpassUser) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -333,31 +2230,31 @@ func (in *SecretPath) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretPathList) DeepCopyInto(out *SecretPathList) {
+func (in *UserpassUserList) DeepCopyInto(out *UserpassUserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SecretPath, len(*in))
+		*out = make([]UserpassUser, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathList.
-func (in *SecretPathList) DeepCopy() *SecretPathList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserList.
+func (in *UserpassUserList) DeepCopy() *UserpassUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretPathList)
+	out := new(UserpassUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretPathList) DeepCopyObject() runtime.Object {
+func (in *UserpassUserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -365,65 +2262,80 @@ func (in *SecretPathList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretPathObservation) DeepCopyInto(out *SecretPathObservation) {
+func (in *UserpassUserObservation) DeepCopyInto(out *UserpassUserObservation) {
 	*out = *in
+	if in.TokenPolicies != nil {
+		in, out := &in.TokenPolicies, &out.TokenPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PasswordLastSetTime != nil {
+		in, out := &in.PasswordLastSetTime, &out.PasswordLastSetTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathObservation.
-func (in *SecretPathObservation) DeepCopy() *SecretPathObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserObservation.
+func (in *UserpassUserObservation) DeepCopy() *UserpassUserObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretPathObservation)
+	out := new(UserpassUserObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretPathParameters) DeepCopyInto(out *SecretPathParameters) {
+func (in *UserpassUserParameters) DeepCopyInto(out *UserpassUserParameters) {
 	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathParameters.
-func (in *SecretPathParameters) DeepCopy() *SecretPathParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserParameters.
+func (in *UserpassUserParameters) DeepCopy() *UserpassUserParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretPathParameters)
+	out := new(UserpassUserParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretPathSpec) DeepCopyInto(out *SecretPathSpec) {
+func (in *UserpassUserSpec) DeepCopyInto(out *UserpassUserSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathSpec.
-func (in *SecretPathSpec) DeepCopy() *SecretPathSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserSpec.
+func (in *UserpassUserSpec) DeepCopy() *UserpassUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretPathSpec)
+	out := new(UserpassUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretPathStatus) DeepCopyInto(out *SecretPathStatus) {
+func (in *UserpassUserStatus) DeepCopyInto(out *UserpassUserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPathStatus.
-func (in *SecretPathStatus) DeepCopy() *SecretPathStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserStatus.
+func (in *UserpassUserStatus) DeepCopy() *UserpassUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretPathStatus)
+	out := new(UserpassUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,179 @@
+package clients
+
+import (
+	"context"
+	"time"
+)
+
+type GetAuthManager func(props map[string][]byte) (AuthManager, error)
+
+// An AuthManager enables, tunes and disables Vault auth methods.
+type AuthManager interface {
+	EnableAuth(ctx context.Context, path string, backend AuthBackend) error
+	GetAuth(ctx context.Context, path string) (*AuthBackend, error)
+	TuneAuth(ctx context.Context, path string, config AuthConfig) error
+	DisableAuth(ctx context.Context, path string) error
+	PutKubernetesRole(ctx context.Context, mount string, name string, role KubernetesRole) error
+	GetKubernetesRole(ctx context.Context, mount string, name string) (*KubernetesRole, error)
+	DeleteKubernetesRole(ctx context.Context, mount string, name string) error
+	PutKubernetesConfig(ctx context.Context, mount string, config KubernetesConfig) error
+	GetKubernetesConfig(ctx context.Context, mount string) (*KubernetesConfig, error)
+	PutAppRole(ctx context.Context, mount string, name string, role AppRole) error
+	GetAppRole(ctx context.Context, mount string, name string) (*AppRole, error)
+	DeleteAppRole(ctx context.Context, mount string, name string) error
+	GetAppRoleID(ctx context.Context, mount string, name string) (string, error)
+	GenerateAppRoleSecretID(ctx context.Context, mount string, name string) (*AppRoleSecretID, error)
+	LookupAppRoleSecretID(ctx context.Context, mount string, name string, accessor string) (*AppRoleSecretID, error)
+	DestroyAppRoleSecretID(ctx context.Context, mount string, name string, accessor string) error
+	PutJWTConfig(ctx context.Context, mount string, config JWTConfig) error
+	GetJWTConfig(ctx context.Context, mount string) (*JWTConfig, error)
+	PutJWTRole(ctx context.Context, mount string, name string, role JWTRole) error
+	GetJWTRole(ctx context.Context, mount string, name string) (*JWTRole, error)
+	DeleteJWTRole(ctx context.Context, mount string, name string) error
+	PutUserpassUser(ctx context.Context, mount string, name string, user UserpassUser) error
+	GetUserpassUser(ctx context.Context, mount string, name string) (*UserpassUser, error)
+	DeleteUserpassUser(ctx context.Context, mount string, name string) error
+	PutCertRole(ctx context.Context, mount string, name string, role CertRole) error
+	GetCertRole(ctx context.Context, mount string, name string) (*CertRole, error)
+	DeleteCertRole(ctx context.Context, mount string, name string) error
+	PutTokenRole(ctx context.Context, name string, role TokenRole) error
+	GetTokenRole(ctx context.Context, name string) (*TokenRole, error)
+	DeleteTokenRole(ctx context.Context, name string) error
+}
+
+// An AuthBackend is an auth method enabled in Vault.
+type AuthBackend struct {
+	Type                  string
+	Config                AuthConfig
+	Accessor              string
+	RunningVersion        string
+	UUID                  string
+	Local                 bool
+	SealWrap              bool
+	ExternalEntropyAccess bool
+}
+
+// AuthConfig is the tunable configuration of an auth method.
+type AuthConfig struct {
+	Description               string
+	DefaultLeaseTTL           time.Duration
+	MaxLeaseTTL               time.Duration
+	ListingVisibility         string
+	TokenType                 string
+	AuditNonHMACRequestKeys   []string
+	AuditNonHMACResponseKeys  []string
+	PassthroughRequestHeaders []string
+	AllowedResponseHeaders    []string
+}
+
+// TokenConfig is the configuration of the tokens issued through an auth
+// method role.
+type TokenConfig struct {
+	Policies        []string
+	TTL             time.Duration
+	MaxTTL          time.Duration
+	Period          time.Duration
+	Type            string
+	BoundCIDRs      []string
+	NoDefaultPolicy bool
+}
+
+// A KubernetesRole lets Kubernetes service accounts log in through the
+// kubernetes auth method.
+type KubernetesRole struct {
+	BoundServiceAccountNames      []string
+	BoundServiceAccountNamespaces []string
+	Audience                      string
+	AliasNameSource               string
+	Token                         TokenConfig
+}
+
+// KubernetesConfig tells the kubernetes auth method how to reach the
+// Kubernetes API server. Vault never returns the token reviewer JWT.
+type KubernetesConfig struct {
+	Host              string
+	CACert            string
+	TokenReviewerJWT  string
+	Issuer            string
+	DisableLocalCAJWT bool
+}
+
+// An AppRole is a role of the approle auth method.
+type AppRole struct {
+	SecretIDTTL        time.Duration
+	SecretIDNumUses    *int
+	SecretIDBoundCIDRs []string
+	Token              TokenConfig
+}
+
+// An AppRoleSecretID is a secret_id issued for an AppRole. The secret_id
+// itself is only known when it is generated.
+type AppRoleSecretID struct {
+	SecretID string
+	Accessor string
+
+	// Expiration is zero when the secret_id never expires.
+	Expiration time.Time
+}
+
+// JWTConfig tells the jwt or oidc auth method how to verify tokens. Vault
+// never returns the OIDC client secret.
+type JWTConfig struct {
+	OIDCDiscoveryURL     string
+	OIDCDiscoveryCAPEM   string
+	OIDCClientID         string
+	OIDCClientSecret     string
+	JWKSURL              string
+	JWKSCAPEM            string
+	JWTValidationPubkeys []string
+	BoundIssuer          string
+	JWTSupportedAlgs     []string
+	DefaultRole          string
+}
+
+// A JWTRole is a role of the jwt or oidc auth method.
+type JWTRole struct {
+	RoleType            string
+	BoundAudiences      []string
+	BoundSubject        string
+	BoundClaims         map[string][]string
+	BoundClaimsType     string
+	ClaimMappings       map[string]string
+	UserClaim           string
+	GroupsClaim         string
+	AllowedRedirectURIs []string
+	OIDCScopes          []string
+	Token               TokenConfig
+}
+
+// A UserpassUser is a user of the userpass auth method. Vault never returns
+// the password, and leaves it unchanged when it is written empty.
+type UserpassUser struct {
+	Password string
+	Token    TokenConfig
+}
+
+// A CertRole is a role of the cert auth method, trusting the client
+// certificates issued by its CA certificate.
+type CertRole struct {
+	Certificate                string
+	AllowedCommonNames         []string
+	AllowedDNSSANs             []string
+	AllowedOrganizationalUnits []string
+	Token                      TokenConfig
+}
+
+// A TokenRole is a role of the token auth method, which tokens can be created
+// from.
+type TokenRole struct {
+	AllowedPolicies        []string
+	DisallowedPolicies     []string
+	AllowedPoliciesGlob    []string
+	DisallowedPoliciesGlob []string
+	Orphan                 bool
+	Renewable              *bool
+	Period                 time.Duration
+	ExplicitMaxTTL         time.Duration
+	Type                   string
+	BoundCIDRs             []string
+}
@@ -1,20 +1,37 @@
 package clients
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type GetPolicyManager func(props map[string][]byte) (PolicyManager, error)
 
 type PolicyManager interface {
-	Put(ctx context.Context, name string, policy Policy) error
+	Put(ctx context.Context, name string, policies []Policy) error
+	PutDocument(ctx context.Context, name string, document string) error
+	PutComposed(ctx context.Context, name string, fragments []PolicyFragment) error
 	Delete(ctx context.Context, name string) error
 	Get(ctx context.Context, name string) ([]Policy, error)
 }
 
+// A PolicyFragment is a named set of rules merged with others into a single
+// composed policy.
+type PolicyFragment struct {
+	Name     string
+	Policies []Policy
+}
+
 type Policy struct {
 	PathConfig PathConfig
 }
 
 type PathConfig struct {
-	Path         string
-	Capabilities []string
+	Path               string
+	Capabilities       []string
+	AllowedParameters  map[string][]string
+	DeniedParameters   map[string][]string
+	RequiredParameters []string
+	MinWrappingTTL     time.Duration
+	MaxWrappingTTL     time.Duration
 }
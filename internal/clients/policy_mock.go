@@ -65,15 +65,43 @@ func (mr *MockPolicyManagerMockRecorder) Get(ctx, name interface{}) *gomock.Call
 }
 
 // Put mocks base method.
-func (m *MockPolicyManager) Put(ctx context.Context, name string, policy Policy) error {
+func (m *MockPolicyManager) Put(ctx context.Context, name string, policies []Policy) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, name, policy)
+	ret := m.ctrl.Call(m, "Put", ctx, name, policies)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Put indicates an expected call of Put.
-func (mr *MockPolicyManagerMockRecorder) Put(ctx, name, policy interface{}) *gomock.Call {
+func (mr *MockPolicyManagerMockRecorder) Put(ctx, name, policies interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPolicyManager)(nil).Put), ctx, name, policy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPolicyManager)(nil).Put), ctx, name, policies)
+}
+
+// PutComposed mocks base method.
+func (m *MockPolicyManager) PutComposed(ctx context.Context, name string, fragments []PolicyFragment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutComposed", ctx, name, fragments)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutComposed indicates an expected call of PutComposed.
+func (mr *MockPolicyManagerMockRecorder) PutComposed(ctx, name, fragments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutComposed", reflect.TypeOf((*MockPolicyManager)(nil).PutComposed), ctx, name, fragments)
+}
+
+// PutDocument mocks base method.
+func (m *MockPolicyManager) PutDocument(ctx context.Context, name, document string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutDocument", ctx, name, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutDocument indicates an expected call of PutDocument.
+func (mr *MockPolicyManagerMockRecorder) PutDocument(ctx, name, document interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDocument", reflect.TypeOf((*MockPolicyManager)(nil).PutDocument), ctx, name, document)
 }
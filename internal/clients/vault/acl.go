@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/vault"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+// ACL evaluates requests offline, with Vault's own ACL engine, against the
+// rules of a set of policies.
+type ACL struct {
+	acl *vault.ACL
+}
+
+// NewACL merges the rules of every policy into a single ACL, the same way
+// Vault does for a token holding all of them.
+func NewACL(ctx context.Context, policies ...[]clients.Policy) (*ACL, error) {
+	parsed := make([]*vault.Policy, 0, len(policies))
+
+	for _, policy := range policies {
+		aclPolicy, err := vault.ParseACLPolicy(namespace.RootNamespace, RenderPolicy(policy))
+
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, aclPolicy)
+	}
+
+	acl, err := vault.NewACL(namespace.RootContext(ctx), parsed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ACL{acl: acl}, nil
+}
+
+// Capabilities returns the capabilities granted on path, or only deny when
+// access to it is not allowed.
+func (a *ACL) Capabilities(ctx context.Context, path string) []string {
+	return a.acl.Capabilities(namespace.RootContext(ctx), path)
+}
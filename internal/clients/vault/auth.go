@@ -0,0 +1,739 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	vaultApi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+func NewVaultAuthManager(props map[string][]byte) (clients.AuthManager, error) {
+	host := string(props["host"])
+	port := string(props["port"])
+	token := string(props["token"])
+
+	return NewAuthManager(host, port, token)
+}
+
+type AuthManager struct {
+	client *vaultApi.Client
+}
+
+func NewAuthManager(host string, port string, token string) (*AuthManager, error) {
+	config := vaultApi.DefaultConfig()
+
+	config.Address = fmt.Sprintf("%s:%s", host, port)
+
+	client, err := vaultApi.NewClient(config)
+	if err != nil {
+		log.Fatalf("unable to initialize Vault client: %v", err)
+	}
+
+	client.SetToken(token)
+
+	return &AuthManager{client: client}, nil
+}
+
+func (a *AuthManager) EnableAuth(ctx context.Context, path string, backend clients.AuthBackend) error {
+	return a.client.Sys().EnableAuthWithOptionsWithContext(ctx, path, &vaultApi.EnableAuthOptions{
+		Type:                  backend.Type,
+		Description:           backend.Config.Description,
+		Config:                authConfigInput(backend.Config),
+		Local:                 backend.Local,
+		SealWrap:              backend.SealWrap,
+		ExternalEntropyAccess: backend.ExternalEntropyAccess,
+	})
+}
+
+// GetAuth returns the auth method and its effective configuration, or nil
+// when it is not enabled. Like for secrets engines, the tune endpoint does
+// not report the description, so it is read from the auth listing.
+func (a *AuthManager) GetAuth(ctx context.Context, path string) (*clients.AuthBackend, error) {
+	mounts, err := a.client.Sys().ListAuthWithContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mount, ok := mounts[mountKey(path)]
+	if !ok {
+		return nil, nil
+	}
+
+	config, err := a.client.Sys().MountConfigWithContext(ctx, authPath(path))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.AuthBackend{
+		Type: mount.Type,
+		Config: clients.AuthConfig{
+			Description:               mount.Description,
+			DefaultLeaseTTL:           time.Duration(config.DefaultLeaseTTL) * time.Second,
+			MaxLeaseTTL:               time.Duration(config.MaxLeaseTTL) * time.Second,
+			ListingVisibility:         config.ListingVisibility,
+			TokenType:                 config.TokenType,
+			AuditNonHMACRequestKeys:   config.AuditNonHMACRequestKeys,
+			AuditNonHMACResponseKeys:  config.AuditNonHMACResponseKeys,
+			PassthroughRequestHeaders: config.PassthroughRequestHeaders,
+			AllowedResponseHeaders:    config.AllowedResponseHeaders,
+		},
+		Accessor:              mount.Accessor,
+		RunningVersion:        mount.RunningVersion,
+		UUID:                  mount.UUID,
+		Local:                 mount.Local,
+		SealWrap:              mount.SealWrap,
+		ExternalEntropyAccess: mount.ExternalEntropyAccess,
+	}, nil
+}
+
+func (a *AuthManager) TuneAuth(ctx context.Context, path string, config clients.AuthConfig) error {
+	return a.client.Sys().TuneMountWithContext(ctx, authPath(path), authConfigInput(config))
+}
+
+func (a *AuthManager) DisableAuth(ctx context.Context, path string) error {
+	return a.client.Sys().DisableAuthWithContext(ctx, path)
+}
+
+// authPath returns the path of an auth method below the auth/ prefix, which
+// the mount endpoints expect.
+func authPath(path string) string {
+	return "auth/" + path
+}
+
+// authConfigInput returns the auth method configuration Vault expects,
+// leaving out the settings that are not set.
+func authConfigInput(config clients.AuthConfig) vaultApi.AuthConfigInput {
+	input := vaultApi.AuthConfigInput{
+		ListingVisibility:         config.ListingVisibility,
+		TokenType:                 config.TokenType,
+		AuditNonHMACRequestKeys:   config.AuditNonHMACRequestKeys,
+		AuditNonHMACResponseKeys:  config.AuditNonHMACResponseKeys,
+		PassthroughRequestHeaders: config.PassthroughRequestHeaders,
+		AllowedResponseHeaders:    config.AllowedResponseHeaders,
+	}
+
+	if config.Description != "" {
+		input.Description = &config.Description
+	}
+
+	if config.DefaultLeaseTTL > 0 {
+		input.DefaultLeaseTTL = config.DefaultLeaseTTL.String()
+	}
+
+	if config.MaxLeaseTTL > 0 {
+		input.MaxLeaseTTL = config.MaxLeaseTTL.String()
+	}
+
+	return input
+}
+
+// rolePath returns the path of a role of the auth method enabled at mount.
+func rolePath(mount string, name string) string {
+	return fmt.Sprintf("%s/role/%s", authPath(strings.Trim(mount, "/")), name)
+}
+
+func (a *AuthManager) PutKubernetesRole(ctx context.Context, mount string, name string, role clients.KubernetesRole) error {
+	data := tokenData(role.Token)
+	data["bound_service_account_names"] = role.BoundServiceAccountNames
+	data["bound_service_account_namespaces"] = role.BoundServiceAccountNamespaces
+
+	if role.Audience != "" {
+		data["audience"] = role.Audience
+	}
+
+	if role.AliasNameSource != "" {
+		data["alias_name_source"] = role.AliasNameSource
+	}
+
+	_, err := a.client.Logical().WriteWithContext(ctx, rolePath(mount, name), data)
+
+	return err
+}
+
+// GetKubernetesRole returns the role, or nil when it does not exist.
+func (a *AuthManager) GetKubernetesRole(ctx context.Context, mount string, name string) (*clients.KubernetesRole, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, rolePath(mount, name))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	return &clients.KubernetesRole{
+		BoundServiceAccountNames:      stringsValue(secret.Data["bound_service_account_names"]),
+		BoundServiceAccountNamespaces: stringsValue(secret.Data["bound_service_account_namespaces"]),
+		Audience:                      stringValue(secret.Data["audience"]),
+		AliasNameSource:               stringValue(secret.Data["alias_name_source"]),
+		Token:                         tokenConfig(secret.Data),
+	}, nil
+}
+
+func (a *AuthManager) DeleteKubernetesRole(ctx context.Context, mount string, name string) error {
+	_, err := a.client.Logical().DeleteWithContext(ctx, rolePath(mount, name))
+
+	return err
+}
+
+// tokenData returns the token parameters of a role as Vault expects them,
+// leaving out the settings that are not set.
+func tokenData(config clients.TokenConfig) map[string]interface{} {
+	data := map[string]interface{}{
+		"token_no_default_policy": config.NoDefaultPolicy,
+	}
+
+	if len(config.Policies) > 0 {
+		data["token_policies"] = config.Policies
+	}
+
+	if config.TTL > 0 {
+		data["token_ttl"] = int64(config.TTL.Seconds())
+	}
+
+	if config.MaxTTL > 0 {
+		data["token_max_ttl"] = int64(config.MaxTTL.Seconds())
+	}
+
+	if config.Period > 0 {
+		data["token_period"] = int64(config.Period.Seconds())
+	}
+
+	if config.Type != "" {
+		data["token_type"] = config.Type
+	}
+
+	if len(config.BoundCIDRs) > 0 {
+		data["token_bound_cidrs"] = config.BoundCIDRs
+	}
+
+	return data
+}
+
+// tokenConfig reads the token parameters of a role out of its data.
+func tokenConfig(data map[string]interface{}) clients.TokenConfig {
+	return clients.TokenConfig{
+		Policies:        stringsValue(data["token_policies"]),
+		TTL:             durationValue(data["token_ttl"]),
+		MaxTTL:          durationValue(data["token_max_ttl"]),
+		Period:          durationValue(data["token_period"]),
+		Type:            stringValue(data["token_type"]),
+		BoundCIDRs:      stringsValue(data["token_bound_cidrs"]),
+		NoDefaultPolicy: boolValue(data["token_no_default_policy"]),
+	}
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func boolValue(v interface{}) bool {
+	b, _ := v.(bool)
+	return b
+}
+
+func stringsValue(v interface{}) []string {
+	values, _ := v.([]interface{})
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func intValue(v interface{}) int64 {
+	switch n := v.(type) {
+	case json.Number:
+		i, _ := n.Int64()
+		return i
+	case float64:
+		return int64(n)
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	}
+
+	return 0
+}
+
+// durationValue reads a duration Vault reports in seconds.
+func durationValue(v interface{}) time.Duration {
+	return time.Duration(intValue(v)) * time.Second
+}
+
+func (a *AuthManager) PutKubernetesConfig(ctx context.Context, mount string, config clients.KubernetesConfig) error {
+	data := map[string]interface{}{
+		"kubernetes_host":      config.Host,
+		"disable_local_ca_jwt": config.DisableLocalCAJWT,
+	}
+
+	if config.CACert != "" {
+		data["kubernetes_ca_cert"] = config.CACert
+	}
+
+	if config.TokenReviewerJWT != "" {
+		data["token_reviewer_jwt"] = config.TokenReviewerJWT
+	}
+
+	if config.Issuer != "" {
+		data["issuer"] = config.Issuer
+	}
+
+	_, err := a.client.Logical().WriteWithContext(ctx, configPath(mount), data)
+
+	return err
+}
+
+// GetKubernetesConfig returns the configuration of the kubernetes auth
+// method, or nil when it has not been configured yet.
+func (a *AuthManager) GetKubernetesConfig(ctx context.Context, mount string) (*clients.KubernetesConfig, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, configPath(mount))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	return &clients.KubernetesConfig{
+		Host:              stringValue(secret.Data["kubernetes_host"]),
+		CACert:            stringValue(secret.Data["kubernetes_ca_cert"]),
+		Issuer:            stringValue(secret.Data["issuer"]),
+		DisableLocalCAJWT: boolValue(secret.Data["disable_local_ca_jwt"]),
+	}, nil
+}
+
+// configPath returns the path of the configuration of the auth method
+// enabled at mount.
+func configPath(mount string) string {
+	return authPath(strings.Trim(mount, "/")) + "/config"
+}
+
+func (a *AuthManager) PutAppRole(ctx context.Context, mount string, name string, role clients.AppRole) error {
+	data := tokenData(role.Token)
+
+	if role.SecretIDTTL > 0 {
+		data["secret_id_ttl"] = int64(role.SecretIDTTL.Seconds())
+	}
+
+	if role.SecretIDNumUses != nil {
+		data["secret_id_num_uses"] = *role.SecretIDNumUses
+	}
+
+	if len(role.SecretIDBoundCIDRs) > 0 {
+		data["secret_id_bound_cidrs"] = role.SecretIDBoundCIDRs
+	}
+
+	_, err := a.client.Logical().WriteWithContext(ctx, rolePath(mount, name), data)
+
+	return err
+}
+
+// GetAppRole returns the role, or nil when it does not exist.
+func (a *AuthManager) GetAppRole(ctx context.Context, mount string, name string) (*clients.AppRole, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, rolePath(mount, name))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	uses := int(intValue(secret.Data["secret_id_num_uses"]))
+
+	return &clients.AppRole{
+		SecretIDTTL:        durationValue(secret.Data["secret_id_ttl"]),
+		SecretIDNumUses:    &uses,
+		SecretIDBoundCIDRs: stringsValue(secret.Data["secret_id_bound_cidrs"]),
+		Token:              tokenConfig(secret.Data),
+	}, nil
+}
+
+func (a *AuthManager) DeleteAppRole(ctx context.Context, mount string, name string) error {
+	_, err := a.client.Logical().DeleteWithContext(ctx, rolePath(mount, name))
+
+	return err
+}
+
+func (a *AuthManager) GetAppRoleID(ctx context.Context, mount string, name string) (string, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, rolePath(mount, name)+"/role-id")
+
+	if err != nil || secret == nil {
+		return "", err
+	}
+
+	return stringValue(secret.Data["role_id"]), nil
+}
+
+func (a *AuthManager) GenerateAppRoleSecretID(ctx context.Context, mount string, name string) (*clients.AppRoleSecretID, error) {
+	secret, err := a.client.Logical().WriteWithContext(ctx, rolePath(mount, name)+"/secret-id", nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, errors.New("no secret_id returned")
+	}
+
+	generated := &clients.AppRoleSecretID{
+		SecretID: stringValue(secret.Data["secret_id"]),
+		Accessor: stringValue(secret.Data["secret_id_accessor"]),
+	}
+
+	if ttl := durationValue(secret.Data["secret_id_ttl"]); ttl > 0 {
+		generated.Expiration = time.Now().Add(ttl)
+	}
+
+	return generated, nil
+}
+
+// DestroyAppRoleSecretID revokes the secret_id identified by accessor. A
+// secret_id that already expired or was used up is left alone.
+func (a *AuthManager) DestroyAppRoleSecretID(ctx context.Context, mount string, name string, accessor string) error {
+	_, err := a.client.Logical().WriteWithContext(ctx, rolePath(mount, name)+"/secret-id-accessor/destroy", map[string]interface{}{
+		"secret_id_accessor": accessor,
+	})
+
+	var responseErr *vaultApi.ResponseError
+	if errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
+
+// LookupAppRoleSecretID returns the secret_id identified by accessor, without
+// the secret_id itself, or nil when it expired or was used up.
+func (a *AuthManager) LookupAppRoleSecretID(ctx context.Context, mount string, name string, accessor string) (*clients.AppRoleSecretID, error) {
+	secret, err := a.client.Logical().WriteWithContext(ctx, rolePath(mount, name)+"/secret-id-accessor/lookup", map[string]interface{}{
+		"secret_id_accessor": accessor,
+	})
+
+	var responseErr *vaultApi.ResponseError
+	if errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	found := &clients.AppRoleSecretID{Accessor: accessor}
+
+	// Vault reports the zero time for secret_ids that never expire.
+	if expiration, err := time.Parse(time.RFC3339Nano, stringValue(secret.Data["expiration_time"])); err == nil && expiration.Year() > 1 {
+		found.Expiration = expiration
+	}
+
+	return found, nil
+}
+
+func (a *AuthManager) PutJWTConfig(ctx context.Context, mount string, config clients.JWTConfig) error {
+	data := map[string]interface{}{
+		"oidc_discovery_url":     config.OIDCDiscoveryURL,
+		"oidc_discovery_ca_pem":  config.OIDCDiscoveryCAPEM,
+		"oidc_client_id":         config.OIDCClientID,
+		"jwks_url":               config.JWKSURL,
+		"jwks_ca_pem":            config.JWKSCAPEM,
+		"jwt_validation_pubkeys": config.JWTValidationPubkeys,
+		"bound_issuer":           config.BoundIssuer,
+		"default_role":           config.DefaultRole,
+	}
+
+	if config.OIDCClientSecret != "" {
+		data["oidc_client_secret"] = config.OIDCClientSecret
+	}
+
+	if len(config.JWTSupportedAlgs) > 0 {
+		data["jwt_supported_algs"] = config.JWTSupportedAlgs
+	}
+
+	_, err := a.client.Logical().WriteWithContext(ctx, configPath(mount), data)
+
+	return err
+}
+
+// GetJWTConfig returns the configuration of the jwt or oidc auth method, or
+// nil when it has not been configured yet.
+func (a *AuthManager) GetJWTConfig(ctx context.Context, mount string) (*clients.JWTConfig, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, configPath(mount))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	return &clients.JWTConfig{
+		OIDCDiscoveryURL:     stringValue(secret.Data["oidc_discovery_url"]),
+		OIDCDiscoveryCAPEM:   stringValue(secret.Data["oidc_discovery_ca_pem"]),
+		OIDCClientID:         stringValue(secret.Data["oidc_client_id"]),
+		JWKSURL:              stringValue(secret.Data["jwks_url"]),
+		JWKSCAPEM:            stringValue(secret.Data["jwks_ca_pem"]),
+		JWTValidationPubkeys: stringsValue(secret.Data["jwt_validation_pubkeys"]),
+		BoundIssuer:          stringValue(secret.Data["bound_issuer"]),
+		JWTSupportedAlgs:     stringsValue(secret.Data["jwt_supported_algs"]),
+		DefaultRole:          stringValue(secret.Data["default_role"]),
+	}, nil
+}
+
+func (a *AuthManager) PutJWTRole(ctx context.Context, mount string, name string, role clients.JWTRole) error {
+	data := tokenData(role.Token)
+
+	data["role_type"] = role.RoleType
+	data["user_claim"] = role.UserClaim
+	data["bound_audiences"] = role.BoundAudiences
+	data["bound_subject"] = role.BoundSubject
+	data["groups_claim"] = role.GroupsClaim
+	data["allowed_redirect_uris"] = role.AllowedRedirectURIs
+	data["oidc_scopes"] = role.OIDCScopes
+
+	// Empty maps rather than nil ones, so that Vault drops the claims that
+	// are no longer declared.
+	data["bound_claims"] = map[string][]string{}
+	if role.BoundClaims != nil {
+		data["bound_claims"] = role.BoundClaims
+	}
+
+	data["claim_mappings"] = map[string]string{}
+	if role.ClaimMappings != nil {
+		data["claim_mappings"] = role.ClaimMappings
+	}
+
+	if role.BoundClaimsType != "" {
+		data["bound_claims_type"] = role.BoundClaimsType
+	}
+
+	_, err := a.client.Logical().WriteWithContext(ctx, rolePath(mount, name), data)
+
+	return err
+}
+
+// GetJWTRole returns the role, or nil when it does not exist.
+func (a *AuthManager) GetJWTRole(ctx context.Context, mount string, name string) (*clients.JWTRole, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, rolePath(mount, name))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	return &clients.JWTRole{
+		RoleType:            stringValue(secret.Data["role_type"]),
+		BoundAudiences:      stringsValue(secret.Data["bound_audiences"]),
+		BoundSubject:        stringValue(secret.Data["bound_subject"]),
+		BoundClaims:         boundClaimsValue(secret.Data["bound_claims"]),
+		BoundClaimsType:     stringValue(secret.Data["bound_claims_type"]),
+		ClaimMappings:       stringMapValue(secret.Data["claim_mappings"]),
+		UserClaim:           stringValue(secret.Data["user_claim"]),
+		GroupsClaim:         stringValue(secret.Data["groups_claim"]),
+		AllowedRedirectURIs: stringsValue(secret.Data["allowed_redirect_uris"]),
+		OIDCScopes:          stringsValue(secret.Data["oidc_scopes"]),
+		Token:               tokenConfig(secret.Data),
+	}, nil
+}
+
+func (a *AuthManager) DeleteJWTRole(ctx context.Context, mount string, name string) error {
+	_, err := a.client.Logical().DeleteWithContext(ctx, rolePath(mount, name))
+
+	return err
+}
+
+// boundClaimsValue reads the bound claims of a jwt role, whose values Vault
+// returns either as a single string or as a list.
+func boundClaimsValue(v interface{}) map[string][]string {
+	claims, _ := v.(map[string]interface{})
+	result := make(map[string][]string, len(claims))
+
+	for claim, value := range claims {
+		if s, ok := value.(string); ok {
+			result[claim] = []string{s}
+			continue
+		}
+
+		result[claim] = stringsValue(value)
+	}
+
+	return result
+}
+
+func stringMapValue(v interface{}) map[string]string {
+	values, _ := v.(map[string]interface{})
+	result := make(map[string]string, len(values))
+
+	for key, value := range values {
+		if s, ok := value.(string); ok {
+			result[key] = s
+		}
+	}
+
+	return result
+}
+
+// userPath returns the path of a user of the userpass auth method enabled at
+// mount.
+func userPath(mount string, name string) string {
+	return fmt.Sprintf("%s/users/%s", authPath(strings.Trim(mount, "/")), name)
+}
+
+func (a *AuthManager) PutUserpassUser(ctx context.Context, mount string, name string, user clients.UserpassUser) error {
+	data := tokenData(user.Token)
+
+	if user.Password != "" {
+		data["password"] = user.Password
+	}
+
+	_, err := a.client.Logical().WriteWithContext(ctx, userPath(mount, name), data)
+
+	return err
+}
+
+// GetUserpassUser returns the user, without its password, or nil when it does
+// not exist.
+func (a *AuthManager) GetUserpassUser(ctx context.Context, mount string, name string) (*clients.UserpassUser, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, userPath(mount, name))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	return &clients.UserpassUser{
+		Token: tokenConfig(secret.Data),
+	}, nil
+}
+
+func (a *AuthManager) DeleteUserpassUser(ctx context.Context, mount string, name string) error {
+	_, err := a.client.Logical().DeleteWithContext(ctx, userPath(mount, name))
+
+	return err
+}
+
+// certPath returns the path of a role of the cert auth method enabled at
+// mount.
+func certPath(mount string, name string) string {
+	return fmt.Sprintf("%s/certs/%s", authPath(strings.Trim(mount, "/")), name)
+}
+
+func (a *AuthManager) PutCertRole(ctx context.Context, mount string, name string, role clients.CertRole) error {
+	data := tokenData(role.Token)
+
+	data["certificate"] = role.Certificate
+	data["allowed_common_names"] = role.AllowedCommonNames
+	data["allowed_dns_sans"] = role.AllowedDNSSANs
+	data["allowed_organizational_units"] = role.AllowedOrganizationalUnits
+
+	_, err := a.client.Logical().WriteWithContext(ctx, certPath(mount, name), data)
+
+	return err
+}
+
+// GetCertRole returns the role, or nil when it does not exist.
+func (a *AuthManager) GetCertRole(ctx context.Context, mount string, name string) (*clients.CertRole, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, certPath(mount, name))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	return &clients.CertRole{
+		Certificate:                stringValue(secret.Data["certificate"]),
+		AllowedCommonNames:         stringsValue(secret.Data["allowed_common_names"]),
+		AllowedDNSSANs:             stringsValue(secret.Data["allowed_dns_sans"]),
+		AllowedOrganizationalUnits: stringsValue(secret.Data["allowed_organizational_units"]),
+		Token:                      tokenConfig(secret.Data),
+	}, nil
+}
+
+func (a *AuthManager) DeleteCertRole(ctx context.Context, mount string, name string) error {
+	_, err := a.client.Logical().DeleteWithContext(ctx, certPath(mount, name))
+
+	return err
+}
+
+// tokenRolePath returns the path of a role of the token auth method.
+func tokenRolePath(name string) string {
+	return authPath("token/roles/" + name)
+}
+
+func (a *AuthManager) PutTokenRole(ctx context.Context, name string, role clients.TokenRole) error {
+	data := map[string]interface{}{
+		"orphan": role.Orphan,
+	}
+
+	if len(role.AllowedPolicies) > 0 {
+		data["allowed_policies"] = role.AllowedPolicies
+	}
+
+	if len(role.DisallowedPolicies) > 0 {
+		data["disallowed_policies"] = role.DisallowedPolicies
+	}
+
+	if len(role.AllowedPoliciesGlob) > 0 {
+		data["allowed_policies_glob"] = role.AllowedPoliciesGlob
+	}
+
+	if len(role.DisallowedPoliciesGlob) > 0 {
+		data["disallowed_policies_glob"] = role.DisallowedPoliciesGlob
+	}
+
+	if role.Renewable != nil {
+		data["renewable"] = *role.Renewable
+	}
+
+	if role.Period > 0 {
+		data["token_period"] = int64(role.Period.Seconds())
+	}
+
+	if role.ExplicitMaxTTL > 0 {
+		data["token_explicit_max_ttl"] = int64(role.ExplicitMaxTTL.Seconds())
+	}
+
+	if role.Type != "" {
+		data["token_type"] = role.Type
+	}
+
+	if len(role.BoundCIDRs) > 0 {
+		data["token_bound_cidrs"] = role.BoundCIDRs
+	}
+
+	_, err := a.client.Logical().WriteWithContext(ctx, tokenRolePath(name), data)
+
+	return err
+}
+
+// GetTokenRole returns the role, or nil when it does not exist.
+func (a *AuthManager) GetTokenRole(ctx context.Context, name string) (*clients.TokenRole, error) {
+	secret, err := a.client.Logical().ReadWithContext(ctx, tokenRolePath(name))
+
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	renewable := boolValue(secret.Data["renewable"])
+
+	return &clients.TokenRole{
+		AllowedPolicies:        stringsValue(secret.Data["allowed_policies"]),
+		DisallowedPolicies:     stringsValue(secret.Data["disallowed_policies"]),
+		AllowedPoliciesGlob:    stringsValue(secret.Data["allowed_policies_glob"]),
+		DisallowedPoliciesGlob: stringsValue(secret.Data["disallowed_policies_glob"]),
+		Orphan:                 boolValue(secret.Data["orphan"]),
+		Renewable:              &renewable,
+		Period:                 durationValue(secret.Data["token_period"]),
+		ExplicitMaxTTL:         durationValue(secret.Data["token_explicit_max_ttl"]),
+		Type:                   stringValue(secret.Data["token_type"]),
+		BoundCIDRs:             stringsValue(secret.Data["token_bound_cidrs"]),
+	}, nil
+}
+
+func (a *AuthManager) DeleteTokenRole(ctx context.Context, name string) error {
+	_, err := a.client.Logical().DeleteWithContext(ctx, tokenRolePath(name))
+
+	return err
+}
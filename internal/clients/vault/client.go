@@ -7,6 +7,9 @@ import (
 	"github.com/munditrade/provider-secret/internal/common"
 	"github.com/pkg/errors"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func New(props map[string][]byte) (common.SecretManager, error) {
@@ -60,17 +63,215 @@ func (m *VaultSecretManager) Put(ctx context.Context, engine string, secretPath
 	}
 }
 
-func (m *VaultSecretManager) CreateEngine(ctx context.Context, engine string, engineType string, options map[string]string) error {
+func (m *VaultSecretManager) CreateEngine(ctx context.Context, engine string, mount common.EngineMount) error {
 	return m.client.Sys().MountWithContext(ctx, engine, &vault.MountInput{
-		Type:    engineType,
-		Options: options,
+		Type:                  mount.Type,
+		Description:           mount.Config.Description,
+		Config:                mountConfigInput(mount.Config),
+		Local:                 mount.Local,
+		SealWrap:              mount.SealWrap,
+		ExternalEntropyAccess: mount.ExternalEntropyAccess,
+		Options:               mount.Config.Options,
+		PluginVersion:         mount.PluginVersion,
 	})
 }
 
 func (m *VaultSecretManager) ExistEngine(ctx context.Context, engine string) (bool, error) {
-	k, _ := m.client.Sys().MountConfigWithContext(ctx, engine)
+	mount, err := m.GetEngine(ctx, engine)
 
-	return k != nil, nil
+	return mount != nil, err
+}
+
+// GetEngine returns the mount and its effective configuration, or nil when
+// it does not exist. The tune endpoint reports the effective lease TTLs but
+// neither the description nor the options, so the rest is read from the
+// mount listing.
+func (m *VaultSecretManager) GetEngine(ctx context.Context, engine string) (*common.Engine, error) {
+	mounts, err := m.client.Sys().ListMountsWithContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mount, ok := mounts[mountKey(engine)]
+	if !ok {
+		return nil, nil
+	}
+
+	config, err := m.client.Sys().MountConfigWithContext(ctx, engine)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.Engine{
+		Config: common.EngineConfig{
+			Description:               mount.Description,
+			DefaultLeaseTTL:           time.Duration(config.DefaultLeaseTTL) * time.Second,
+			MaxLeaseTTL:               time.Duration(config.MaxLeaseTTL) * time.Second,
+			ListingVisibility:         config.ListingVisibility,
+			AuditNonHMACRequestKeys:   config.AuditNonHMACRequestKeys,
+			PassthroughRequestHeaders: config.PassthroughRequestHeaders,
+			AllowedResponseHeaders:    config.AllowedResponseHeaders,
+			Options:                   mount.Options,
+		},
+		Accessor:       mount.Accessor,
+		Type:           mount.Type,
+		RunningVersion: mount.RunningVersion,
+		UUID:           mount.UUID,
+		Local:          mount.Local,
+		SealWrap:       mount.SealWrap,
+	}, nil
+}
+
+func (m *VaultSecretManager) TuneEngine(ctx context.Context, engine string, config common.EngineConfig) error {
+	return m.client.Sys().TuneMountWithContext(ctx, engine, mountConfigInput(config))
+}
+
+// mountConfigInput returns the mount configuration Vault expects, leaving out
+// the settings that are not set.
+func mountConfigInput(config common.EngineConfig) vault.MountConfigInput {
+	input := vault.MountConfigInput{
+		ListingVisibility:         config.ListingVisibility,
+		AuditNonHMACRequestKeys:   config.AuditNonHMACRequestKeys,
+		PassthroughRequestHeaders: config.PassthroughRequestHeaders,
+		AllowedResponseHeaders:    config.AllowedResponseHeaders,
+		Options:                   config.Options,
+	}
+
+	if config.Description != "" {
+		input.Description = &config.Description
+	}
+
+	if config.DefaultLeaseTTL > 0 {
+		input.DefaultLeaseTTL = config.DefaultLeaseTTL.String()
+	}
+
+	if config.MaxLeaseTTL > 0 {
+		input.MaxLeaseTTL = config.MaxLeaseTTL.String()
+	}
+
+	return input
+}
+
+// RemountEngine moves a mount to a new path and returns the ID of the
+// migration. Vault versions prior to 1.10 move it synchronously and return no
+// ID.
+func (m *VaultSecretManager) RemountEngine(ctx context.Context, from string, to string) (string, error) {
+	secret, err := m.client.Logical().WriteWithContext(ctx, "sys/remount", map[string]interface{}{
+		"from": from,
+		"to":   to,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if secret == nil || secret.Data == nil {
+		return "", nil
+	}
+
+	id, _ := secret.Data["migration_id"].(string)
+
+	return id, nil
+}
+
+func (m *VaultSecretManager) GetRemountStatus(ctx context.Context, migrationID string) (string, error) {
+	status, err := m.client.Sys().RemountStatusWithContext(ctx, migrationID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if status.MigrationInfo == nil {
+		return "", errors.Errorf("no status reported for migration %s", migrationID)
+	}
+
+	return status.MigrationInfo.MigrationStatus, nil
+}
+
+func (m *VaultSecretManager) GetKVConfig(ctx context.Context, engine string) (*common.KVConfig, error) {
+	secret, err := m.client.Logical().ReadWithContext(ctx, engine+"/config")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil || secret.Data == nil {
+		return nil, errors.Errorf("no configuration found for %s", engine)
+	}
+
+	maxVersions, err := strconv.Atoi(fmt.Sprint(secret.Data["max_versions"]))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read max_versions")
+	}
+
+	casRequired, _ := secret.Data["cas_required"].(bool)
+
+	deleteVersionAfter, err := time.ParseDuration(fmt.Sprint(secret.Data["delete_version_after"]))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read delete_version_after")
+	}
+
+	return &common.KVConfig{
+		MaxVersions:        &maxVersions,
+		CASRequired:        &casRequired,
+		DeleteVersionAfter: &deleteVersionAfter,
+	}, nil
+}
+
+func (m *VaultSecretManager) PutKVConfig(ctx context.Context, engine string, config common.KVConfig) error {
+	data := make(map[string]interface{})
+
+	if config.MaxVersions != nil {
+		data["max_versions"] = *config.MaxVersions
+	}
+
+	if config.CASRequired != nil {
+		data["cas_required"] = *config.CASRequired
+	}
+
+	if config.DeleteVersionAfter != nil {
+		data["delete_version_after"] = config.DeleteVersionAfter.String()
+	}
+
+	_, err := m.client.Logical().WriteWithContext(ctx, engine+"/config", data)
+
+	return err
+}
+
+// mountKey returns the key Vault uses for a mount path when listing mounts.
+func mountKey(engine string) string {
+	return strings.Trim(engine, "/") + "/"
+}
+
+// ListEngine returns the keys at the root of a kv mount, reading the metadata
+// of version 2 mounts.
+func (m *VaultSecretManager) ListEngine(ctx context.Context, engine string, options map[string]string) ([]string, error) {
+	path := engine
+
+	if getVersion(options) != "1" {
+		path = engine + "/metadata"
+	}
+
+	secret, err := m.client.Logical().ListWithContext(ctx, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil || secret.Data == nil {
+		return nil, nil
+	}
+
+	keys, _ := secret.Data["keys"].([]interface{})
+	result := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		result = append(result, fmt.Sprint(key))
+	}
+
+	return result, nil
 }
 
 func (m *VaultSecretManager) DeleteEngine(ctx context.Context, engine string) error {
@@ -3,12 +3,15 @@ package vault
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
 	vaultApi "github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/helper/namespace"
 	"github.com/hashicorp/vault/vault"
 	"github.com/munditrade/provider-secret/internal/clients"
 	"github.com/munditrade/provider-secret/internal/clients/exceptions"
 	"log"
+	"sort"
 	"strings"
 )
 
@@ -43,13 +46,114 @@ func NewPolicyManager(host string, port string, token string) (*PolicyManager, e
 	return &PolicyManager{client: client}, nil
 }
 
-func (p *PolicyManager) Put(ctx context.Context, name string, policy clients.Policy) error {
-	rules := strings.Trim(fmt.Sprintf(`path "%s" { capabilities = ["%s"] }`,
-		policy.PathConfig.Path,
-		strings.Join(policy.PathConfig.Capabilities, "\",\""),
-	), "")
+func (p *PolicyManager) Put(ctx context.Context, name string, policies []clients.Policy) error {
+	return p.client.Sys().PutPolicyWithContext(ctx, name, RenderPolicy(policies))
+}
+
+func (p *PolicyManager) PutDocument(ctx context.Context, name string, document string) error {
+	return p.client.Sys().PutPolicyWithContext(ctx, name, document)
+}
+
+func (p *PolicyManager) PutComposed(ctx context.Context, name string, fragments []clients.PolicyFragment) error {
+	return p.client.Sys().PutPolicyWithContext(ctx, name, RenderComposedPolicy(fragments))
+}
+
+// RenderPolicy builds a single ACL document holding one path stanza per
+// policy, so that every rule of a Policy is written to Vault at once.
+func RenderPolicy(policies []clients.Policy) string {
+	stanzas := make([]string, 0, len(policies))
+
+	for _, policy := range policies {
+		stanzas = append(stanzas, renderPathConfig(policy.PathConfig))
+	}
+
+	return strings.Join(stanzas, "\n")
+}
+
+// RenderComposedPolicy builds a single ACL document out of several fragments,
+// each one introduced by a comment naming where its rules come from.
+func RenderComposedPolicy(fragments []clients.PolicyFragment) string {
+	sections := make([]string, 0, len(fragments))
+
+	for _, fragment := range fragments {
+		sections = append(sections, fmt.Sprintf("# fragment: %s\n%s", fragment.Name, RenderPolicy(fragment.Policies)))
+	}
+
+	return strings.Join(sections, "\n")
+}
+
+func renderPathConfig(config clients.PathConfig) string {
+	var stanza strings.Builder
+
+	fmt.Fprintf(&stanza, "path %q {\n", config.Path)
+	fmt.Fprintf(&stanza, "  capabilities = %s\n", renderList(config.Capabilities))
+
+	if len(config.AllowedParameters) > 0 {
+		stanza.WriteString(renderParameters("allowed_parameters", config.AllowedParameters))
+	}
+
+	if len(config.DeniedParameters) > 0 {
+		stanza.WriteString(renderParameters("denied_parameters", config.DeniedParameters))
+	}
+
+	if len(config.RequiredParameters) > 0 {
+		fmt.Fprintf(&stanza, "  required_parameters = %s\n", renderList(config.RequiredParameters))
+	}
+
+	if config.MinWrappingTTL > 0 {
+		fmt.Fprintf(&stanza, "  min_wrapping_ttl = %q\n", config.MinWrappingTTL.String())
+	}
+
+	if config.MaxWrappingTTL > 0 {
+		fmt.Fprintf(&stanza, "  max_wrapping_ttl = %q\n", config.MaxWrappingTTL.String())
+	}
+
+	stanza.WriteString("}\n")
+
+	return stanza.String()
+}
+
+func renderParameters(key string, parameters map[string][]string) string {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var block strings.Builder
+
+	fmt.Fprintf(&block, "  %s = {\n", key)
+	for _, name := range names {
+		fmt.Fprintf(&block, "    %q = %s\n", name, renderList(parameters[name]))
+	}
+	block.WriteString("  }\n")
 
-	return p.client.Sys().PutPolicyWithContext(ctx, name, rules)
+	return block.String()
+}
+
+func renderList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func readParameters(parameters map[string][]interface{}) map[string][]string {
+	if parameters == nil {
+		return nil
+	}
+
+	result := make(map[string][]string, len(parameters))
+	for name, values := range parameters {
+		result[name] = make([]string, 0, len(values))
+		for _, value := range values {
+			result[name] = append(result[name], fmt.Sprint(value))
+		}
+	}
+
+	return result
 }
 
 func (p *PolicyManager) Delete(ctx context.Context, name string) error {
@@ -67,17 +171,87 @@ func (p *PolicyManager) Get(ctx context.Context, name string) ([]clients.Policy,
 		return nil, err
 	}
 
-	policies := make([]clients.Policy, 0)
-	aclPolicy, parseErr := vault.ParseACLPolicy(namespace.RootNamespace, policyAsStr)
+	return ParseDocument(policyAsStr)
+}
+
+// legacyCapabilities are the capabilities the legacy policy attribute of a
+// path grants, as Vault maps them.
+var legacyCapabilities = map[string][]string{
+	vault.OldDenyPathPolicy:  {vault.DenyCapability},
+	vault.OldReadPathPolicy:  {vault.ReadCapability, vault.ListCapability},
+	vault.OldWritePathPolicy: {vault.CreateCapability, vault.ReadCapability, vault.UpdateCapability, vault.DeleteCapability, vault.ListCapability},
+	vault.OldSudoPathPolicy:  {vault.CreateCapability, vault.ReadCapability, vault.UpdateCapability, vault.DeleteCapability, vault.ListCapability, vault.SudoCapability},
+}
+
+// ParseRawDocument returns the paths of an HCL or JSON ACL policy and their
+// capabilities as they are written, before Vault validates and normalizes
+// them: unknown capabilities are kept, and so are the capabilities declared
+// along with deny. Errors read like the ones of ParseDocument.
+func ParseRawDocument(document string) ([]clients.Policy, error) {
+	root, err := hcl.Parse(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse policy: does not contain a root object")
+	}
+
+	items := list.Filter("path").Items
+	policies := make([]clients.Policy, 0, len(items))
+
+	for _, item := range items {
+		var path struct {
+			Policy       string   `hcl:"policy"`
+			Capabilities []string `hcl:"capabilities"`
+		}
+
+		if err := hcl.DecodeObject(&path, item.Val); err != nil {
+			return nil, fmt.Errorf("failed to parse policy: %w", err)
+		}
+
+		pattern := "path"
+		if len(item.Keys) > 0 {
+			pattern = item.Keys[0].Token.Value().(string)
+		}
 
-	if parseErr != nil {
-		return nil, parseErr
+		policies = append(policies, clients.Policy{PathConfig: clients.PathConfig{
+			Path:         strings.TrimPrefix(pattern, "/"),
+			Capabilities: append(path.Capabilities, legacyCapabilities[path.Policy]...),
+		}})
 	}
 
+	return policies, nil
+}
+
+// ParseDocument parses an HCL or JSON ACL policy the same way Vault does.
+// Errors carry the position of the offending token in the document.
+func ParseDocument(document string) ([]clients.Policy, error) {
+	aclPolicy, err := vault.ParseACLPolicy(namespace.RootNamespace, document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]clients.Policy, 0, len(aclPolicy.Paths))
+
 	for _, path := range aclPolicy.Paths {
+		// Vault strips the trailing glob of prefix paths, restore it so the
+		// pattern reads back exactly as it was written.
+		pattern := path.Path
+		if path.IsPrefix {
+			pattern += "*"
+		}
+
 		policies = append(policies, clients.Policy{PathConfig: clients.PathConfig{
-			Path:         path.Path,
-			Capabilities: path.Capabilities,
+			Path:               pattern,
+			Capabilities:       path.Capabilities,
+			AllowedParameters:  readParameters(path.Permissions.AllowedParameters),
+			DeniedParameters:   readParameters(path.Permissions.DeniedParameters),
+			RequiredParameters: path.Permissions.RequiredParameters,
+			MinWrappingTTL:     path.Permissions.MinWrappingTTL,
+			MaxWrappingTTL:     path.Permissions.MaxWrappingTTL,
 		}})
 	}
 
@@ -1,6 +1,9 @@
 package common
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type GetNewSecretManager func(props map[string][]byte) (SecretManager, error)
 
@@ -11,8 +14,57 @@ const (
 type SecretManager interface {
 	Put(ctx context.Context, engine string, secretPath string, data map[string]interface{}, options map[string]string) error
 	GetSecrets(ctx context.Context, engine string, secretPath string, options map[string]string) (map[string]interface{}, error)
-	CreateEngine(ctx context.Context, engine string, engineType string, options map[string]string) error
+	CreateEngine(ctx context.Context, engine string, mount EngineMount) error
 	ExistEngine(ctx context.Context, engine string) (bool, error)
 	DeletePath(ctx context.Context, engine string, secretPath string, options map[string]string) error
 	DeleteEngine(ctx context.Context, engine string) error
+	ListEngine(ctx context.Context, engine string, options map[string]string) ([]string, error)
+	GetEngine(ctx context.Context, engine string) (*Engine, error)
+	TuneEngine(ctx context.Context, engine string, config EngineConfig) error
+	RemountEngine(ctx context.Context, from string, to string) (string, error)
+	GetRemountStatus(ctx context.Context, migrationID string) (string, error)
+	GetKVConfig(ctx context.Context, engine string) (*KVConfig, error)
+	PutKVConfig(ctx context.Context, engine string, config KVConfig) error
+}
+
+// EngineMount describes a secrets engine to mount.
+type EngineMount struct {
+	Type                  string
+	PluginVersion         string
+	Config                EngineConfig
+	Local                 bool
+	SealWrap              bool
+	ExternalEntropyAccess bool
+}
+
+// EngineConfig is the tunable configuration of a secrets engine mount. Zero
+// values are left untouched when tuning.
+type EngineConfig struct {
+	Description               string
+	DefaultLeaseTTL           time.Duration
+	MaxLeaseTTL               time.Duration
+	ListingVisibility         string
+	AuditNonHMACRequestKeys   []string
+	PassthroughRequestHeaders []string
+	AllowedResponseHeaders    []string
+	Options                   map[string]string
+}
+
+// Engine describes a secrets engine mount as reported by Vault.
+type Engine struct {
+	Config         EngineConfig
+	Accessor       string
+	Type           string
+	RunningVersion string
+	UUID           string
+	Local          bool
+	SealWrap       bool
+}
+
+// KVConfig is the backend configuration of a kv version 2 mount. Nil fields
+// are left untouched when writing it.
+type KVConfig struct {
+	MaxVersions        *int
+	CASRequired        *bool
+	DeleteVersionAfter *time.Duration
 }
@@ -31,17 +31,17 @@ func (m *MockSecretManager) EXPECT() *MockSecretManagerMockRecorder {
 }
 
 // CreateEngine mocks base method.
-func (m *MockSecretManager) CreateEngine(ctx context.Context, engine, engineType string, options map[string]string) error {
+func (m *MockSecretManager) CreateEngine(ctx context.Context, engine string, mount EngineMount) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEngine", ctx, engine, engineType, options)
+	ret := m.ctrl.Call(m, "CreateEngine", ctx, engine, mount)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateEngine indicates an expected call of CreateEngine.
-func (mr *MockSecretManagerMockRecorder) CreateEngine(ctx, engine, engineType, options interface{}) *gomock.Call {
+func (mr *MockSecretManagerMockRecorder) CreateEngine(ctx, engine, mount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEngine", reflect.TypeOf((*MockSecretManager)(nil).CreateEngine), ctx, engine, engineType, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEngine", reflect.TypeOf((*MockSecretManager)(nil).CreateEngine), ctx, engine, mount)
 }
 
 // DeleteEngine mocks base method.
@@ -87,6 +87,51 @@ func (mr *MockSecretManagerMockRecorder) ExistEngine(ctx, engine interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistEngine", reflect.TypeOf((*MockSecretManager)(nil).ExistEngine), ctx, engine)
 }
 
+// GetEngine mocks base method.
+func (m *MockSecretManager) GetEngine(ctx context.Context, engine string) (*Engine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEngine", ctx, engine)
+	ret0, _ := ret[0].(*Engine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEngine indicates an expected call of GetEngine.
+func (mr *MockSecretManagerMockRecorder) GetEngine(ctx, engine interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEngine", reflect.TypeOf((*MockSecretManager)(nil).GetEngine), ctx, engine)
+}
+
+// GetKVConfig mocks base method.
+func (m *MockSecretManager) GetKVConfig(ctx context.Context, engine string) (*KVConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKVConfig", ctx, engine)
+	ret0, _ := ret[0].(*KVConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKVConfig indicates an expected call of GetKVConfig.
+func (mr *MockSecretManagerMockRecorder) GetKVConfig(ctx, engine interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKVConfig", reflect.TypeOf((*MockSecretManager)(nil).GetKVConfig), ctx, engine)
+}
+
+// GetRemountStatus mocks base method.
+func (m *MockSecretManager) GetRemountStatus(ctx context.Context, migrationID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemountStatus", ctx, migrationID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemountStatus indicates an expected call of GetRemountStatus.
+func (mr *MockSecretManagerMockRecorder) GetRemountStatus(ctx, migrationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemountStatus", reflect.TypeOf((*MockSecretManager)(nil).GetRemountStatus), ctx, migrationID)
+}
+
 // GetSecrets mocks base method.
 func (m *MockSecretManager) GetSecrets(ctx context.Context, engine, secretPath string, options map[string]string) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +147,21 @@ func (mr *MockSecretManagerMockRecorder) GetSecrets(ctx, engine, secretPath, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecrets", reflect.TypeOf((*MockSecretManager)(nil).GetSecrets), ctx, engine, secretPath, options)
 }
 
+// ListEngine mocks base method.
+func (m *MockSecretManager) ListEngine(ctx context.Context, engine string, options map[string]string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEngine", ctx, engine, options)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEngine indicates an expected call of ListEngine.
+func (mr *MockSecretManagerMockRecorder) ListEngine(ctx, engine, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEngine", reflect.TypeOf((*MockSecretManager)(nil).ListEngine), ctx, engine, options)
+}
+
 // Put mocks base method.
 func (m *MockSecretManager) Put(ctx context.Context, engine, secretPath string, data map[string]interface{}, options map[string]string) error {
 	m.ctrl.T.Helper()
@@ -115,3 +175,46 @@ func (mr *MockSecretManagerMockRecorder) Put(ctx, engine, secretPath, data, opti
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockSecretManager)(nil).Put), ctx, engine, secretPath, data, options)
 }
+
+// PutKVConfig mocks base method.
+func (m *MockSecretManager) PutKVConfig(ctx context.Context, engine string, config KVConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutKVConfig", ctx, engine, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutKVConfig indicates an expected call of PutKVConfig.
+func (mr *MockSecretManagerMockRecorder) PutKVConfig(ctx, engine, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutKVConfig", reflect.TypeOf((*MockSecretManager)(nil).PutKVConfig), ctx, engine, config)
+}
+
+// RemountEngine mocks base method.
+func (m *MockSecretManager) RemountEngine(ctx context.Context, from, to string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemountEngine", ctx, from, to)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemountEngine indicates an expected call of RemountEngine.
+func (mr *MockSecretManagerMockRecorder) RemountEngine(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemountEngine", reflect.TypeOf((*MockSecretManager)(nil).RemountEngine), ctx, from, to)
+}
+
+// TuneEngine mocks base method.
+func (m *MockSecretManager) TuneEngine(ctx context.Context, engine string, config EngineConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TuneEngine", ctx, engine, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TuneEngine indicates an expected call of TuneEngine.
+func (mr *MockSecretManagerMockRecorder) TuneEngine(ctx, engine, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TuneEngine", reflect.TypeOf((*MockSecretManager)(nil).TuneEngine), ctx, engine, config)
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+// TokenConfig returns the configuration of the tokens issued through an auth
+// method role.
+func TokenConfig(params v1alpha1.TokenParameters) clients.TokenConfig {
+	config := clients.TokenConfig{
+		Policies:        params.TokenPolicies,
+		Type:            params.TokenType,
+		BoundCIDRs:      params.TokenBoundCIDRs,
+		NoDefaultPolicy: params.TokenNoDefaultPolicy,
+	}
+
+	if params.TokenTTL != nil {
+		config.TTL = params.TokenTTL.Duration
+	}
+
+	if params.TokenMaxTTL != nil {
+		config.MaxTTL = params.TokenMaxTTL.Duration
+	}
+
+	if params.TokenPeriod != nil {
+		config.Period = params.TokenPeriod.Duration
+	}
+
+	return config
+}
+
+// TokenDrift compares the token configuration of a role with the declared
+// one. Settings left unset are not managed, so Vault defaults never count as
+// drift.
+func TokenDrift(params v1alpha1.TokenParameters, current *clients.TokenConfig) []string {
+	desired := TokenConfig(params)
+	drifts := make([]string, 0)
+
+	if len(desired.Policies) > 0 && !SameSet(SanitizePolicies(desired.Policies), SanitizePolicies(current.Policies)) {
+		drifts = append(drifts, "token policies differ")
+	}
+
+	if params.TokenTTL != nil && desired.TTL != current.TTL {
+		drifts = append(drifts, "token TTL differs")
+	}
+
+	if params.TokenMaxTTL != nil && desired.MaxTTL != current.MaxTTL {
+		drifts = append(drifts, "token max TTL differs")
+	}
+
+	if params.TokenPeriod != nil && desired.Period != current.Period {
+		drifts = append(drifts, "token period differs")
+	}
+
+	if desired.Type != "" && desired.Type != current.Type {
+		drifts = append(drifts, "token type differs")
+	}
+
+	if len(desired.BoundCIDRs) > 0 && !SameSet(desired.BoundCIDRs, current.BoundCIDRs) {
+		drifts = append(drifts, "token bound CIDRs differ")
+	}
+
+	if desired.NoDefaultPolicy != current.NoDefaultPolicy {
+		drifts = append(drifts, "token no default policy differs")
+	}
+
+	return drifts
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+func TestTokenDrift(t *testing.T) {
+	cases := map[string]struct {
+		params  v1alpha1.TokenParameters
+		current *clients.TokenConfig
+		want    []string
+	}{
+		"should compare token policies the way Vault stores them": {
+			params:  v1alpha1.TokenParameters{TokenPolicies: []string{"Deploy", " read ", "deploy"}},
+			current: &clients.TokenConfig{Policies: []string{"read", "deploy"}},
+			want:    []string{},
+		},
+		"should report token policies that differ": {
+			params:  v1alpha1.TokenParameters{TokenPolicies: []string{"deploy", "admin"}},
+			current: &clients.TokenConfig{Policies: []string{"read", "deploy"}},
+			want:    []string{"token policies differ"},
+		},
+		"should not manage token policies left unset": {
+			params:  v1alpha1.TokenParameters{},
+			current: &clients.TokenConfig{Policies: []string{"read"}},
+			want:    []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(testCase.want, TokenDrift(testCase.params, testCase.current)); diff != "" {
+				t.Errorf("TokenDrift(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
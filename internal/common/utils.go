@@ -2,14 +2,23 @@ package common
 
 import (
 	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	ErrNoParentReferences = "CR does not have parent ref"
+	ErrGetSecretKey       = "cannot get Secret %s/%s"
+	ErrNoSecretKey        = "Secret %s/%s has no key %s"
+	ErrGetConfigMapKey    = "cannot get ConfigMap %s/%s"
+	ErrNoConfigMapKey     = "ConfigMap %s/%s has no key %s"
 )
 
 func getOwnerEngine(ctx context.Context, reader client.Reader, ns string, engineName string) (*v1alpha1.Engine, error) {
@@ -25,3 +34,140 @@ func getOwnerEngine(ctx context.Context, reader client.Reader, ns string, engine
 func GetOwnerEngine(ctx context.Context, reader client.Reader, ns string, engineName string) (*v1alpha1.Engine, error) {
 	return getOwnerEngine(ctx, reader, ns, engineName)
 }
+
+// MountPath returns the path an Engine is mounted at in Vault: its path
+// parameter when set, otherwise its external name, falling back to its name.
+func MountPath(engine *v1alpha1.Engine) string {
+	path := engine.Spec.ForProvider.Path
+
+	if path == "" {
+		path = meta.GetExternalName(engine)
+	}
+
+	if path == "" {
+		path = engine.ObjectMeta.Name
+	}
+
+	return strings.Trim(path, "/")
+}
+
+// MountedPath returns the path an Engine was last observed at in Vault, which
+// differs from its desired mount path until a remount completes.
+func MountedPath(engine *v1alpha1.Engine) string {
+	if path := engine.Status.AtProvider.Path; path != "" {
+		return path
+	}
+
+	return MountPath(engine)
+}
+
+// AuthPath returns the path an AuthBackend is enabled at, below auth/ in
+// Vault, resolved like the mount path of an Engine. The token auth method is
+// always at token.
+func AuthPath(backend *v1alpha1.AuthBackend) string {
+	if backend.Spec.ForProvider.Type == v1alpha1.AuthToken {
+		return v1alpha1.AuthToken
+	}
+
+	path := backend.Spec.ForProvider.Path
+
+	if path == "" {
+		path = meta.GetExternalName(backend)
+	}
+
+	if path == "" {
+		path = backend.ObjectMeta.Name
+	}
+
+	return strings.Trim(path, "/")
+}
+
+// MountOptions returns the options an Engine passes to its mount, with its
+// typed options taking precedence over the passthrough ones.
+func MountOptions(params v1alpha1.EngineParameters) map[string]string {
+	if params.KV == nil {
+		return params.Options
+	}
+
+	options := make(map[string]string, len(params.Options)+1)
+	for name, value := range params.Options {
+		options[name] = value
+	}
+
+	options["version"] = params.KV.Version
+
+	return options
+}
+
+// SecretValue returns the value of the key of a Secret selected by ref.
+func SecretValue(ctx context.Context, reader client.Reader, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrapf(err, ErrGetSecretKey, ref.Namespace, ref.Name)
+	}
+
+	value, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(ErrNoSecretKey, ref.Namespace, ref.Name, ref.Key)
+	}
+
+	return string(value), nil
+}
+
+// ConfigMapValue returns the value of the key of a ConfigMap selected by ref.
+func ConfigMapValue(ctx context.Context, reader client.Reader, ref v1alpha1.ConfigMapKeySelector) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return "", errors.Wrapf(err, ErrGetConfigMapKey, ref.Namespace, ref.Name)
+	}
+
+	value, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(ErrNoConfigMapKey, ref.Namespace, ref.Name, ref.Key)
+	}
+
+	return value, nil
+}
+
+// SameSet reports whether both lists hold the same elements as often,
+// ignoring order.
+func SameSet(old []string, current []string) bool {
+	if len(old) != len(current) {
+		return false
+	}
+
+	counts := make(map[string]int, len(current))
+	for _, e := range current {
+		counts[e]++
+	}
+
+	for _, e := range old {
+		if counts[e] == 0 {
+			return false
+		}
+
+		counts[e]--
+	}
+
+	return true
+}
+
+// SanitizePolicies returns policy names the way Vault stores them: trimmed,
+// lowercased, without empty names or repetitions.
+func SanitizePolicies(policies []string) []string {
+	sanitized := make([]string, 0, len(policies))
+	seen := make(map[string]bool, len(policies))
+
+	for _, p := range policies {
+		p = strings.ToLower(strings.TrimSpace(p))
+
+		if p == "" || seen[p] {
+			continue
+		}
+
+		seen[p] = true
+		sanitized = append(sanitized, p)
+	}
+
+	return sanitized
+}
@@ -0,0 +1,45 @@
+package common
+
+import "testing"
+
+func TestSameSet(t *testing.T) {
+	cases := map[string]struct {
+		old     []string
+		current []string
+		want    bool
+	}{
+		"should ignore the order of the elements": {
+			old:     []string{"a", "b"},
+			current: []string{"b", "a"},
+			want:    true,
+		},
+		"should tell lists of different lengths apart": {
+			old:     []string{"a"},
+			current: []string{"a", "a"},
+			want:    false,
+		},
+		"should count repeated elements": {
+			old:     []string{"a", "a"},
+			current: []string{"a", "b"},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			if got := SameSet(testCase.old, testCase.current); got != testCase.want {
+				t.Errorf("SameSet(%v, %v): want %t, got %t", testCase.old, testCase.current, testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestSanitizePolicies(t *testing.T) {
+	got := SanitizePolicies([]string{" Deploy", "deploy", "", "READ"})
+	want := []string{"deploy", "read"}
+
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("SanitizePolicies(...): want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,425 @@
+package approlerole
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotAppRoleRole = "managed resource is not an AppRoleRole custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+	errNoSecretRef    = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret      = "cannot get credentials Secret"
+	errGetRole        = "cannot get approle role"
+	errPutRole        = "cannot write approle role"
+	errDeleteRole     = "cannot delete approle role"
+	errGetRoleID      = "cannot get approle role_id"
+	errGenerateSecret = "cannot generate approle secret_id"
+	errLookupSecret   = "cannot look up approle secret_id"
+	errDestroySecret  = "cannot destroy approle secret_id"
+
+	errNewClient = "cannot create new Service"
+
+	diffSecretIDMissing = "secret_id is missing"
+	diffSecretIDExpires = "secret_id expires at %s"
+	diffSecretIDStale   = "superseded secret_id is not destroyed"
+
+	defaultMount = v1alpha1.AuthAppRole
+)
+
+// Setup adds a controller that reconciles AppRoleRole managed resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.AppRoleRoleGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AppRoleRoleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-approlerole", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.AppRoleRole{}).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AppRoleRole)
+	if !ok {
+		return nil, errors.New(errNotAppRoleRole)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc, now: time.Now}, nil
+}
+
+type external struct {
+	service clients.AuthManager
+	now     func() time.Time
+}
+
+// roleName returns the name of the role in Vault: the external name of the
+// AppRoleRole, falling back to its name.
+func roleName(cr *v1alpha1.AppRoleRole) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+
+	return cr.GetName()
+}
+
+// mount returns the path the approle auth method is enabled at.
+func mount(cr *v1alpha1.AppRoleRole) string {
+	if m := strings.Trim(cr.Spec.ForProvider.Mount, "/"); m != "" {
+		return m
+	}
+
+	return defaultMount
+}
+
+// appRole returns the role declared by the AppRoleRole.
+func appRole(params v1alpha1.AppRoleRoleParameters) clients.AppRole {
+	role := clients.AppRole{
+		SecretIDNumUses:    params.SecretIDNumUses,
+		SecretIDBoundCIDRs: params.SecretIDBoundCIDRs,
+		Token:              common.TokenConfig(params.TokenParameters),
+	}
+
+	if params.SecretIDTTL != nil {
+		role.SecretIDTTL = params.SecretIDTTL.Duration
+	}
+
+	return role
+}
+
+// roleDrift compares the role Vault holds with the declared one, ignoring
+// the settings left unset.
+func roleDrift(params v1alpha1.AppRoleRoleParameters, current *clients.AppRole) []string {
+	desired := appRole(params)
+	drifts := make([]string, 0)
+
+	if params.SecretIDTTL != nil && desired.SecretIDTTL != current.SecretIDTTL {
+		drifts = append(drifts, "secret_id TTL differs")
+	}
+
+	if desired.SecretIDNumUses != nil && (current.SecretIDNumUses == nil || *desired.SecretIDNumUses != *current.SecretIDNumUses) {
+		drifts = append(drifts, "secret_id num uses differ")
+	}
+
+	if len(desired.SecretIDBoundCIDRs) > 0 && !common.SameSet(desired.SecretIDBoundCIDRs, current.SecretIDBoundCIDRs) {
+		drifts = append(drifts, "secret_id bound CIDRs differ")
+	}
+
+	return append(drifts, common.TokenDrift(params.TokenParameters, &current.Token)...)
+}
+
+// rotateBefore returns how long before its expiry the secret_id is replaced.
+func rotateBefore(params v1alpha1.AppRoleRoleParameters) time.Duration {
+	if params.SecretIDRotateBefore != nil {
+		return params.SecretIDRotateBefore.Duration
+	}
+
+	if params.SecretIDTTL != nil {
+		return params.SecretIDTTL.Duration / 3
+	}
+
+	return 0
+}
+
+// rotationDue tells why the published secret_id must be replaced, if it
+// must: it is gone, or it expires within the rotation window.
+func (c *external) rotationDue(cr *v1alpha1.AppRoleRole) string {
+	o := cr.Status.AtProvider
+
+	if o.SecretIDAccessor == "" {
+		return diffSecretIDMissing
+	}
+
+	if o.SecretIDExpiration == nil {
+		return ""
+	}
+
+	if c.now().Add(rotateBefore(cr.Spec.ForProvider)).Before(o.SecretIDExpiration.Time) {
+		return ""
+	}
+
+	return fmt.Sprintf(diffSecretIDExpires, o.SecretIDExpiration.Format(time.RFC3339))
+}
+
+// observeSecretID refreshes the status of the published secret_id, clearing
+// it when Vault no longer knows it.
+func (c *external) observeSecretID(ctx context.Context, cr *v1alpha1.AppRoleRole) error {
+	o := &cr.Status.AtProvider
+	if o.SecretIDAccessor == "" {
+		return nil
+	}
+
+	found, err := c.service.LookupAppRoleSecretID(ctx, mount(cr), roleName(cr), o.SecretIDAccessor)
+
+	if err != nil {
+		return errors.Wrap(err, errLookupSecret)
+	}
+
+	if found == nil {
+		o.SecretIDAccessor = ""
+		o.SecretIDExpiration = nil
+		return nil
+	}
+
+	setSecretID(cr, found)
+
+	return nil
+}
+
+// setSecretID records the published secret_id in the AppRoleRole status.
+func setSecretID(cr *v1alpha1.AppRoleRole, secretID *clients.AppRoleSecretID) {
+	o := &cr.Status.AtProvider
+
+	o.SecretIDAccessor = secretID.Accessor
+	o.SecretIDExpiration = nil
+
+	if !secretID.Expiration.IsZero() {
+		expiration := metav1.NewTime(secretID.Expiration)
+		o.SecretIDExpiration = &expiration
+	}
+}
+
+// issue generates a new secret_id and returns it along with the role_id as
+// connection details.
+func (c *external) issue(ctx context.Context, cr *v1alpha1.AppRoleRole) (managed.ConnectionDetails, error) {
+	roleID, err := c.service.GetAppRoleID(ctx, mount(cr), roleName(cr))
+
+	if err != nil {
+		return nil, errors.Wrap(err, errGetRoleID)
+	}
+
+	secretID, err := c.service.GenerateAppRoleSecretID(ctx, mount(cr), roleName(cr))
+
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateSecret)
+	}
+
+	cr.Status.AtProvider.RoleID = roleID
+	setSecretID(cr, secretID)
+
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyRoleID:   []byte(roleID),
+		v1alpha1.ConnectionKeySecretID: []byte(secretID.SecretID),
+	}, nil
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AppRoleRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAppRoleRole)
+	}
+
+	role, err := c.service.GetAppRole(ctx, mount(cr), roleName(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRole)
+	}
+
+	if role == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	roleID, err := c.service.GetAppRoleID(ctx, mount(cr), roleName(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRoleID)
+	}
+
+	cr.Status.AtProvider.Path = fmt.Sprintf("auth/%s/role/%s", mount(cr), roleName(cr))
+	cr.Status.AtProvider.RoleID = roleID
+
+	if err := c.observeSecretID(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	details := managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyRoleID: []byte(roleID),
+	}
+
+	drifts := roleDrift(cr.Spec.ForProvider, role)
+
+	if due := c.rotationDue(cr); due != "" {
+		drifts = append(drifts, due)
+	}
+
+	if cr.Status.AtProvider.SupersededSecretIDAccessor != "" {
+		drifts = append(drifts, diffSecretIDStale)
+	}
+
+	if len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: details,
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: details,
+	}, nil
+}
+
+// Create writes the role only. The secret_id is issued by the first Update:
+// the status of the AppRoleRole is reverted once Create returns, so the
+// accessor of a secret_id issued here would be lost and the secret_id would
+// remain valid in Vault without the provider tracking it.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AppRoleRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAppRoleRole)
+	}
+
+	if err := c.service.PutAppRole(ctx, mount(cr), roleName(cr), appRole(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPutRole)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// destroySuperseded destroys the secret_id a rotation replaced. It runs in a
+// later reconcile than the rotation, once the new secret_id is published.
+func (c *external) destroySuperseded(ctx context.Context, cr *v1alpha1.AppRoleRole) error {
+	o := &cr.Status.AtProvider
+	if o.SupersededSecretIDAccessor == "" {
+		return nil
+	}
+
+	if err := c.service.DestroyAppRoleSecretID(ctx, mount(cr), roleName(cr), o.SupersededSecretIDAccessor); err != nil {
+		return errors.Wrap(err, errDestroySecret)
+	}
+
+	o.SupersededSecretIDAccessor = ""
+
+	return nil
+}
+
+// Update writes the role, destroys the secret_id superseded by a previous
+// rotation and, when the published secret_id is missing or due for rotation,
+// publishes a new one. The secret_id it replaces stays valid until the next
+// Update, so that clients always hold a valid one.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AppRoleRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAppRoleRole)
+	}
+
+	if err := c.service.PutAppRole(ctx, mount(cr), roleName(cr), appRole(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPutRole)
+	}
+
+	if err := c.destroySuperseded(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if c.rotationDue(cr) == "" {
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	superseded := cr.Status.AtProvider.SecretIDAccessor
+
+	details, err := c.issue(ctx, cr)
+
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	cr.Status.AtProvider.SupersededSecretIDAccessor = superseded
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: details,
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AppRoleRole)
+	if !ok {
+		return errors.New(errNotAppRoleRole)
+	}
+
+	return errors.Wrap(c.service.DeleteAppRole(ctx, mount(cr), roleName(cr)), errDeleteRole)
+}
@@ -0,0 +1,373 @@
+package approlerole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+var now = time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func clock() time.Time {
+	return now
+}
+
+func role(accessor string) *v1alpha1.AppRoleRole {
+	return &v1alpha1.AppRoleRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "ci-runner",
+		},
+		Spec: v1alpha1.AppRoleRoleSpec{
+			ForProvider: v1alpha1.AppRoleRoleParameters{
+				SecretIDTTL: &v1.Duration{Duration: 30 * 24 * time.Hour},
+				TokenParameters: v1alpha1.TokenParameters{
+					TokenPolicies: []string{"ci"},
+				},
+			},
+		},
+		Status: v1alpha1.AppRoleRoleStatus{
+			AtProvider: v1alpha1.AppRoleRoleObservation{SecretIDAccessor: accessor},
+		},
+	}
+}
+
+func TestAppRoleRole_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		accessor    string
+		err         error
+	}
+
+	current := &clients.AppRole{
+		SecretIDTTL: 30 * 24 * time.Hour,
+		Token:       clients.TokenConfig{Policies: []string{"ci"}},
+	}
+
+	roleID := managed.ConnectionDetails{v1alpha1.ConnectionKeyRoleID: []byte("role-1234")}
+
+	cases := map[string]struct {
+		reason      string
+		accessor    string
+		superseded  string
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when Vault has no such role": {
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAppRole(gomock.Any(), "approle", "ci-runner").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date while the secret_id is far from expiring": {
+			accessor: "accessor-1",
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: roleID,
+				},
+				accessor: "accessor-1",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAppRole(gomock.Any(), "approle", "ci-runner").Return(current, nil).Times(1)
+				m.EXPECT().GetAppRoleID(gomock.Any(), "approle", "ci-runner").Return("role-1234", nil).Times(1)
+				m.EXPECT().LookupAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-1").Return(&clients.AppRoleSecretID{
+					Accessor:   "accessor-1",
+					Expiration: now.Add(20 * 24 * time.Hour),
+				}, nil).Times(1)
+			},
+		},
+		"should rotate the secret_id within a third of its TTL from expiry": {
+			accessor: "accessor-1",
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "secret_id expires at 2022-10-10T12:00:00Z",
+					ConnectionDetails: roleID,
+				},
+				accessor: "accessor-1",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAppRole(gomock.Any(), "approle", "ci-runner").Return(current, nil).Times(1)
+				m.EXPECT().GetAppRoleID(gomock.Any(), "approle", "ci-runner").Return("role-1234", nil).Times(1)
+				m.EXPECT().LookupAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-1").Return(&clients.AppRoleSecretID{
+					Accessor:   "accessor-1",
+					Expiration: now.Add(9 * 24 * time.Hour),
+				}, nil).Times(1)
+			},
+		},
+		"should issue a secret_id when the published one is gone": {
+			accessor: "accessor-1",
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "secret_id is missing",
+					ConnectionDetails: roleID,
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAppRole(gomock.Any(), "approle", "ci-runner").Return(current, nil).Times(1)
+				m.EXPECT().GetAppRoleID(gomock.Any(), "approle", "ci-runner").Return("role-1234", nil).Times(1)
+				m.EXPECT().LookupAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-1").Return(nil, nil).Times(1)
+			},
+		},
+		"should destroy the secret_id superseded by a rotation": {
+			accessor:   "accessor-1",
+			superseded: "accessor-0",
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "superseded secret_id is not destroyed",
+					ConnectionDetails: roleID,
+				},
+				accessor: "accessor-1",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAppRole(gomock.Any(), "approle", "ci-runner").Return(current, nil).Times(1)
+				m.EXPECT().GetAppRoleID(gomock.Any(), "approle", "ci-runner").Return("role-1234", nil).Times(1)
+				m.EXPECT().LookupAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-1").Return(&clients.AppRoleSecretID{
+					Accessor:   "accessor-1",
+					Expiration: now.Add(30 * 24 * time.Hour),
+				}, nil).Times(1)
+			},
+		},
+		"should fail when the secret_id cannot be looked up": {
+			accessor: "accessor-1",
+			want: want{
+				err:      errors.Wrap(errors.New("boom"), errLookupSecret),
+				accessor: "accessor-1",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAppRole(gomock.Any(), "approle", "ci-runner").Return(current, nil).Times(1)
+				m.EXPECT().GetAppRoleID(gomock.Any(), "approle", "ci-runner").Return("role-1234", nil).Times(1)
+				m.EXPECT().LookupAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-1").Return(nil, errors.New("boom")).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, now: clock}
+			cr := role(testCase.accessor)
+			cr.Status.AtProvider.SupersededSecretIDAccessor = testCase.superseded
+
+			got, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.accessor, cr.Status.AtProvider.SecretIDAccessor); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want accessor, +got accessor:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAppRoleRole_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutAppRole(gomock.Any(), "approle", "ci-runner", clients.AppRole{
+		SecretIDTTL: 30 * 24 * time.Hour,
+		Token:       clients.TokenConfig{Policies: []string{"ci"}},
+	}).Return(nil).Times(1)
+
+	e := external{service: mock, now: clock}
+
+	got, err := e.Create(context.Background(), role(""))
+
+	if err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+
+	want := managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+// TestAppRoleRole_Lifecycle walks an AppRoleRole through its first
+// reconciles, re-fetching it after Create the way the managed reconciler
+// does, to check that exactly one secret_id is issued and tracked.
+func TestAppRoleRole_Lifecycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	current := &clients.AppRole{
+		SecretIDTTL: 30 * 24 * time.Hour,
+		Token:       clients.TokenConfig{Policies: []string{"ci"}},
+	}
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutAppRole(gomock.Any(), "approle", "ci-runner", gomock.Any()).Return(nil).Times(2)
+	mock.EXPECT().GetAppRole(gomock.Any(), "approle", "ci-runner").Return(current, nil).Times(2)
+	mock.EXPECT().GetAppRoleID(gomock.Any(), "approle", "ci-runner").Return("role-1234", nil).Times(3)
+	mock.EXPECT().GenerateAppRoleSecretID(gomock.Any(), "approle", "ci-runner").Return(&clients.AppRoleSecretID{
+		SecretID:   "secret-5678",
+		Accessor:   "accessor-1",
+		Expiration: now.Add(30 * 24 * time.Hour),
+	}, nil).Times(1)
+	mock.EXPECT().LookupAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-1").Return(&clients.AppRoleSecretID{
+		Accessor:   "accessor-1",
+		Expiration: now.Add(30 * 24 * time.Hour),
+	}, nil).Times(1)
+
+	e := external{service: mock, now: clock}
+
+	if _, err := e.Create(context.Background(), role("")); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+
+	// The reconciler re-fetches the AppRoleRole once Create returns, which
+	// reverts any status Create recorded.
+	cr := role("")
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if o.ResourceUpToDate || o.Diff != diffSecretIDMissing {
+		t.Fatalf("e.Observe(...): want a missing secret_id, got %+v", o)
+	}
+
+	u, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]byte("secret-5678"), u.ConnectionDetails[v1alpha1.ConnectionKeySecretID]); diff != "" {
+		t.Errorf("e.Update(...): -want secret_id, +got secret_id:\n%s\n", diff)
+	}
+
+	o, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want up to date after the secret_id was issued, got %+v", o)
+	}
+}
+
+func TestAppRoleRole_Update(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	cases := map[string]struct {
+		reason         string
+		expiration     time.Time
+		superseded     string
+		want           managed.ExternalUpdate
+		wantSuperseded string
+		err            error
+		prepareMock    prepareMock
+	}{
+		"should only write the role while the secret_id is valid": {
+			expiration: now.Add(20 * 24 * time.Hour),
+			want: managed.ExternalUpdate{
+				ConnectionDetails: managed.ConnectionDetails{},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().PutAppRole(gomock.Any(), "approle", "ci-runner", gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		"should publish a new secret_id and keep the old one valid when rotation is due": {
+			expiration: now.Add(24 * time.Hour),
+			want: managed.ExternalUpdate{
+				ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyRoleID:   []byte("role-1234"),
+					v1alpha1.ConnectionKeySecretID: []byte("secret-9999"),
+				},
+			},
+			wantSuperseded: "accessor-1",
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().PutAppRole(gomock.Any(), "approle", "ci-runner", gomock.Any()).Return(nil).Times(1)
+				m.EXPECT().GetAppRoleID(gomock.Any(), "approle", "ci-runner").Return("role-1234", nil).Times(1)
+				m.EXPECT().GenerateAppRoleSecretID(gomock.Any(), "approle", "ci-runner").Return(&clients.AppRoleSecretID{
+					SecretID: "secret-9999",
+					Accessor: "accessor-2",
+				}, nil).Times(1)
+			},
+		},
+		"should destroy the secret_id superseded by the previous rotation": {
+			expiration: now.Add(20 * 24 * time.Hour),
+			superseded: "accessor-0",
+			want: managed.ExternalUpdate{
+				ConnectionDetails: managed.ConnectionDetails{},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().PutAppRole(gomock.Any(), "approle", "ci-runner", gomock.Any()).Return(nil).Times(1)
+				m.EXPECT().DestroyAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-0").Return(nil).Times(1)
+			},
+		},
+		"should keep the superseded secret_id when it cannot be destroyed": {
+			expiration:     now.Add(24 * time.Hour),
+			superseded:     "accessor-0",
+			wantSuperseded: "accessor-0",
+			err:            errors.Wrap(errors.New("boom"), errDestroySecret),
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().PutAppRole(gomock.Any(), "approle", "ci-runner", gomock.Any()).Return(nil).Times(1)
+				m.EXPECT().DestroyAppRoleSecretID(gomock.Any(), "approle", "ci-runner", "accessor-0").Return(errors.New("boom")).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, now: clock}
+			cr := role("accessor-1")
+			expiration := v1.NewTime(testCase.expiration)
+			cr.Status.AtProvider.SecretIDExpiration = &expiration
+			cr.Status.AtProvider.SupersededSecretIDAccessor = testCase.superseded
+
+			got, err := e.Update(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.wantSuperseded, cr.Status.AtProvider.SupersededSecretIDAccessor); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want superseded accessor, +got superseded accessor:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package authbackend
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotAuthBackend = "managed resource is not an AuthBackend custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+	errNoSecretRef    = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret      = "cannot get credentials Secret"
+	errGetAuth        = "cannot get auth method"
+	errEnableAuth     = "cannot enable auth method"
+	errTuneAuth       = "cannot tune auth method"
+	errDisableAuth    = "cannot disable auth method"
+	errTypeMismatch   = "auth method at %s is of type %s, not %s"
+
+	errNewClient = "cannot create new Service"
+)
+
+// Setup adds a controller that reconciles AuthBackend managed resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.AuthBackendGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AuthBackendGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-authbackend", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.AuthBackend{}).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AuthBackend)
+	if !ok {
+		return nil, errors.New(errNotAuthBackend)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+type external struct {
+	service clients.AuthManager
+}
+
+// authConfig returns the tunable configuration declared by the AuthBackend.
+func authConfig(params v1alpha1.AuthBackendParameters) clients.AuthConfig {
+	config := clients.AuthConfig{
+		Description:               params.Description,
+		ListingVisibility:         params.ListingVisibility,
+		TokenType:                 params.TokenType,
+		AuditNonHMACRequestKeys:   params.AuditNonHMACRequestKeys,
+		AuditNonHMACResponseKeys:  params.AuditNonHMACResponseKeys,
+		PassthroughRequestHeaders: params.PassthroughRequestHeaders,
+		AllowedResponseHeaders:    params.AllowedResponseHeaders,
+	}
+
+	if params.DefaultLeaseTTL != nil {
+		config.DefaultLeaseTTL = params.DefaultLeaseTTL.Duration
+	}
+
+	if params.MaxLeaseTTL != nil {
+		config.MaxLeaseTTL = params.MaxLeaseTTL.Duration
+	}
+
+	return config
+}
+
+// authDrift compares the configuration of the auth method with the one
+// declared by the AuthBackend. As for engines, settings left unset are not
+// managed, so Vault defaults never count as drift.
+func authDrift(params v1alpha1.AuthBackendParameters, current *clients.AuthConfig) []string {
+	desired := authConfig(params)
+	drifts := make([]string, 0)
+
+	if desired.Description != "" && desired.Description != current.Description {
+		drifts = append(drifts, "description differs")
+	}
+
+	if params.DefaultLeaseTTL != nil && desired.DefaultLeaseTTL != current.DefaultLeaseTTL {
+		drifts = append(drifts, "default lease TTL differs")
+	}
+
+	if params.MaxLeaseTTL != nil && desired.MaxLeaseTTL != current.MaxLeaseTTL {
+		drifts = append(drifts, "max lease TTL differs")
+	}
+
+	if desired.ListingVisibility != "" && desired.ListingVisibility != current.ListingVisibility {
+		drifts = append(drifts, "listing visibility differs")
+	}
+
+	if desired.TokenType != "" && desired.TokenType != current.TokenType {
+		drifts = append(drifts, "token type differs")
+	}
+
+	if len(desired.AuditNonHMACRequestKeys) > 0 && !common.SameSet(desired.AuditNonHMACRequestKeys, current.AuditNonHMACRequestKeys) {
+		drifts = append(drifts, "audit non-HMAC request keys differ")
+	}
+
+	if len(desired.AuditNonHMACResponseKeys) > 0 && !common.SameSet(desired.AuditNonHMACResponseKeys, current.AuditNonHMACResponseKeys) {
+		drifts = append(drifts, "audit non-HMAC response keys differ")
+	}
+
+	if len(desired.PassthroughRequestHeaders) > 0 && !common.SameSet(desired.PassthroughRequestHeaders, current.PassthroughRequestHeaders) {
+		drifts = append(drifts, "passthrough request headers differ")
+	}
+
+	if len(desired.AllowedResponseHeaders) > 0 && !common.SameSet(desired.AllowedResponseHeaders, current.AllowedResponseHeaders) {
+		drifts = append(drifts, "allowed response headers differ")
+	}
+
+	return drifts
+}
+
+// setObservation records the path and the metadata of the auth method in the
+// AuthBackend status.
+func setObservation(cr *v1alpha1.AuthBackend, path string, backend *clients.AuthBackend) {
+	o := &cr.Status.AtProvider
+
+	o.Path = path
+	o.Accessor = backend.Accessor
+	o.Type = backend.Type
+	o.PluginVersion = backend.RunningVersion
+	o.UUID = backend.UUID
+	o.DefaultLeaseTTL = &metav1.Duration{Duration: backend.Config.DefaultLeaseTTL}
+	o.MaxLeaseTTL = &metav1.Duration{Duration: backend.Config.MaxLeaseTTL}
+	o.TokenType = backend.Config.TokenType
+	o.Local = backend.Local
+	o.SealWrap = backend.SealWrap
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AuthBackend)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAuthBackend)
+	}
+
+	path := common.AuthPath(cr)
+
+	backend, err := c.service.GetAuth(ctx, path)
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAuth)
+	}
+
+	if backend == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// Another auth method already lives at the path, which tuning would not
+	// turn into the declared one.
+	if backend.Type != cr.Spec.ForProvider.Type {
+		return managed.ExternalObservation{}, errors.Errorf(errTypeMismatch, path, backend.Type, cr.Spec.ForProvider.Type)
+	}
+
+	setObservation(cr, path, backend)
+
+	if drifts := authDrift(cr.Spec.ForProvider, &backend.Config); len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AuthBackend)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAuthBackend)
+	}
+
+	params := cr.Spec.ForProvider
+
+	err := c.service.EnableAuth(ctx, common.AuthPath(cr), clients.AuthBackend{
+		Type:     params.Type,
+		Config:   authConfig(params),
+		Local:    params.Local,
+		SealWrap: params.SealWrap,
+	})
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errEnableAuth)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AuthBackend)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAuthBackend)
+	}
+
+	if err := c.service.TuneAuth(ctx, common.AuthPath(cr), authConfig(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errTuneAuth)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AuthBackend)
+	if !ok {
+		return errors.New(errNotAuthBackend)
+	}
+
+	// The token auth method cannot be disabled, so deleting the AuthBackend
+	// only stops managing its tuning.
+	if cr.Spec.ForProvider.Type == v1alpha1.AuthToken {
+		return nil
+	}
+
+	return errors.Wrap(c.service.DisableAuth(ctx, common.AuthPath(cr)), errDisableAuth)
+}
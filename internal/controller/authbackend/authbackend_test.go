@@ -0,0 +1,252 @@
+package authbackend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+func backend(params v1alpha1.AuthBackendParameters) *v1alpha1.AuthBackend {
+	return &v1alpha1.AuthBackend{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "kubernetes",
+		},
+		Spec: v1alpha1.AuthBackendSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func TestAuthBackend_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		accessor    string
+		err         error
+	}
+
+	params := v1alpha1.AuthBackendParameters{
+		Type:            "kubernetes",
+		Description:     "platform workloads",
+		DefaultLeaseTTL: &v1.Duration{Duration: time.Hour},
+		TokenType:       "service",
+	}
+
+	current := &clients.AuthBackend{
+		Type: "kubernetes",
+		Config: clients.AuthConfig{
+			Description:     "platform workloads",
+			DefaultLeaseTTL: time.Hour,
+			MaxLeaseTTL:     768 * time.Hour,
+			TokenType:       "service",
+		},
+		Accessor: "auth_kubernetes_1234",
+	}
+
+	drifted := *current
+	drifted.Config.DefaultLeaseTTL = 30 * time.Minute
+	drifted.Config.TokenType = "default-service"
+
+	cases := map[string]struct {
+		reason      string
+		params      v1alpha1.AuthBackendParameters
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when the auth method is not enabled": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAuth(gomock.Any(), "kubernetes").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date and report the accessor": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				accessor: "auth_kubernetes_1234",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAuth(gomock.Any(), "kubernetes").Return(current, nil).Times(1)
+			},
+		},
+		"should report the settings that drifted": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "default lease TTL differs; token type differs",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				accessor: "auth_kubernetes_1234",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAuth(gomock.Any(), "kubernetes").Return(&drifted, nil).Times(1)
+			},
+		},
+		"should fail when another auth method lives at the path": {
+			params: v1alpha1.AuthBackendParameters{Type: "approle"},
+			want: want{
+				err: errors.Errorf(errTypeMismatch, "kubernetes", "kubernetes", "approle"),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAuth(gomock.Any(), "kubernetes").Return(current, nil).Times(1)
+			},
+		},
+		"should observe the token auth method at token": {
+			params: v1alpha1.AuthBackendParameters{Type: "token", MaxLeaseTTL: &v1.Duration{Duration: 768 * time.Hour}},
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				accessor: "auth_token_5678",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetAuth(gomock.Any(), "token").Return(&clients.AuthBackend{
+					Type:     "token",
+					Config:   clients.AuthConfig{MaxLeaseTTL: 768 * time.Hour},
+					Accessor: "auth_token_5678",
+				}, nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			cr := backend(testCase.params)
+
+			got, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.accessor, cr.Status.AtProvider.Accessor); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want accessor, +got accessor:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAuthBackend_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().EnableAuth(gomock.Any(), "platform/kubernetes", clients.AuthBackend{
+		Type: "kubernetes",
+		Config: clients.AuthConfig{
+			Description: "platform workloads",
+			MaxLeaseTTL: 24 * time.Hour,
+		},
+		Local: true,
+	}).Return(nil).Times(1)
+
+	e := external{service: mock}
+	cr := backend(v1alpha1.AuthBackendParameters{
+		Type:        "kubernetes",
+		Description: "platform workloads",
+		MaxLeaseTTL: &v1.Duration{Duration: 24 * time.Hour},
+		Local:       true,
+	})
+	meta.SetExternalName(cr, "/platform/kubernetes/")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+}
+
+func TestAuthBackend_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().TuneAuth(gomock.Any(), "kubernetes", clients.AuthConfig{
+		DefaultLeaseTTL: time.Hour,
+		TokenType:       "batch",
+	}).Return(errors.New("boom")).Times(1)
+
+	e := external{service: mock}
+	cr := backend(v1alpha1.AuthBackendParameters{
+		Type:            "kubernetes",
+		DefaultLeaseTTL: &v1.Duration{Duration: time.Hour},
+		TokenType:       "batch",
+	})
+
+	_, err := e.Update(context.Background(), cr)
+
+	if diff := cmp.Diff(errors.Wrap(errors.New("boom"), errTuneAuth), err, test.EquateErrors()); diff != "" {
+		t.Errorf("e.Update(...): -want error, +got error:\n%s\n", diff)
+	}
+}
+
+func TestAuthBackend_Delete(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	cases := map[string]struct {
+		reason      string
+		params      v1alpha1.AuthBackendParameters
+		prepareMock prepareMock
+	}{
+		"should disable the auth method": {
+			params: v1alpha1.AuthBackendParameters{Type: "kubernetes"},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().DisableAuth(gomock.Any(), "kubernetes").Return(nil).Times(1)
+			},
+		},
+		"should leave the token auth method enabled": {
+			params:      v1alpha1.AuthBackendParameters{Type: "token"},
+			prepareMock: func(m *clients.MockAuthManager) {},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+
+			if err := e.Delete(context.Background(), backend(testCase.params)); err != nil {
+				t.Errorf("\n%s\ne.Delete(...): unexpected error: %v", testCase.reason, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package certauthrole
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotCertAuthRole = "managed resource is not a CertAuthRole custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errGetPC           = "cannot get ProviderConfig"
+	errNoSecretRef     = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret       = "cannot get credentials Secret"
+	errGetCertificate  = "cannot get CA certificate"
+	errNoCertificate   = "no CA certificate is referenced"
+	errGetRole         = "cannot get cert auth role"
+	errPutRole         = "cannot write cert auth role"
+	errDeleteRole      = "cannot delete cert auth role"
+
+	errNewClient = "cannot create new Service"
+
+	defaultMount = v1alpha1.AuthCert
+)
+
+// Setup adds a controller that reconciles CertAuthRole managed resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.CertAuthRoleGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CertAuthRoleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-certauthrole", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.CertAuthRole{}).
+			Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(certificateRoles(mgr.GetClient(), secretReferenced))).
+			Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(certificateRoles(mgr.GetClient(), configMapReferenced))).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+// secretReferenced reports whether the CertAuthRole reads its CA certificate
+// from the Secret.
+func secretReferenced(params v1alpha1.CertAuthRoleParameters, obj client.Object) bool {
+	ref := params.CertificateSecretRef
+	return ref != nil && ref.Name == obj.GetName() && ref.Namespace == obj.GetNamespace()
+}
+
+// configMapReferenced reports whether the CertAuthRole reads its CA
+// certificate from the ConfigMap.
+func configMapReferenced(params v1alpha1.CertAuthRoleParameters, obj client.Object) bool {
+	ref := params.CertificateConfigMapRef
+	return ref != nil && ref.Name == obj.GetName() && ref.Namespace == obj.GetNamespace()
+}
+
+// certificateRoles maps a Secret or a ConfigMap to the CertAuthRoles reading
+// their CA certificate from it, so that rotating the CA re-applies them.
+func certificateRoles(kube client.Reader, referenced func(v1alpha1.CertAuthRoleParameters, client.Object) bool) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		roles := &v1alpha1.CertAuthRoleList{}
+		if err := kube.List(context.TODO(), roles); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0)
+
+		for i := range roles.Items {
+			if referenced(roles.Items[i].Spec.ForProvider, obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: roles.Items[i].GetName()}})
+			}
+		}
+
+		return requests
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CertAuthRole)
+	if !ok {
+		return nil, errors.New(errNotCertAuthRole)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc, kube: c.kube}, nil
+}
+
+type external struct {
+	service clients.AuthManager
+	kube    client.Reader
+}
+
+// roleName returns the name of the role in Vault: the external name of the
+// CertAuthRole, falling back to its name.
+func roleName(cr *v1alpha1.CertAuthRole) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+
+	return cr.GetName()
+}
+
+// mount returns the path the cert auth method is enabled at.
+func mount(cr *v1alpha1.CertAuthRole) string {
+	if m := strings.Trim(cr.Spec.ForProvider.Mount, "/"); m != "" {
+		return m
+	}
+
+	return defaultMount
+}
+
+// certificate returns the CA certificate read from the referenced Secret or
+// ConfigMap.
+func (c *external) certificate(ctx context.Context, params v1alpha1.CertAuthRoleParameters) (string, error) {
+	var (
+		pem string
+		err error
+	)
+
+	switch {
+	case params.CertificateSecretRef != nil:
+		pem, err = common.SecretValue(ctx, c.kube, *params.CertificateSecretRef)
+	case params.CertificateConfigMapRef != nil:
+		pem, err = common.ConfigMapValue(ctx, c.kube, *params.CertificateConfigMapRef)
+	default:
+		return "", errors.New(errNoCertificate)
+	}
+
+	return strings.TrimSpace(pem), errors.Wrap(err, errGetCertificate)
+}
+
+// certRole returns the role declared by the CertAuthRole, with its CA
+// certificate read from the Secret or ConfigMap it references.
+func (c *external) certRole(ctx context.Context, params v1alpha1.CertAuthRoleParameters) (clients.CertRole, error) {
+	pem, err := c.certificate(ctx, params)
+
+	if err != nil {
+		return clients.CertRole{}, err
+	}
+
+	return clients.CertRole{
+		Certificate:                pem,
+		AllowedCommonNames:         params.AllowedCommonNames,
+		AllowedDNSSANs:             params.AllowedDNSSANs,
+		AllowedOrganizationalUnits: params.AllowedOrganizationalUnits,
+		Token:                      common.TokenConfig(params.TokenParameters),
+	}, nil
+}
+
+// roleDrift compares the role Vault holds with the declared one.
+func roleDrift(params v1alpha1.CertAuthRoleParameters, desired clients.CertRole, current *clients.CertRole) []string {
+	drifts := make([]string, 0)
+
+	if desired.Certificate != strings.TrimSpace(current.Certificate) {
+		drifts = append(drifts, "CA certificate differs")
+	}
+
+	if !common.SameSet(desired.AllowedCommonNames, current.AllowedCommonNames) {
+		drifts = append(drifts, "allowed common names differ")
+	}
+
+	if !common.SameSet(desired.AllowedDNSSANs, current.AllowedDNSSANs) {
+		drifts = append(drifts, "allowed DNS SANs differ")
+	}
+
+	if !common.SameSet(desired.AllowedOrganizationalUnits, current.AllowedOrganizationalUnits) {
+		drifts = append(drifts, "allowed organizational units differ")
+	}
+
+	return append(drifts, common.TokenDrift(params.TokenParameters, &current.Token)...)
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CertAuthRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCertAuthRole)
+	}
+
+	role, err := c.service.GetCertRole(ctx, mount(cr), roleName(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRole)
+	}
+
+	if role == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.Status.AtProvider.Path = fmt.Sprintf("auth/%s/certs/%s", mount(cr), roleName(cr))
+	cr.Status.AtProvider.TokenPolicies = role.Token.Policies
+
+	// The certificate source of a deleted role may be gone already, and is
+	// not needed to delete it.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	desired, err := c.certRole(ctx, cr.Spec.ForProvider)
+
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if drifts := roleDrift(cr.Spec.ForProvider, desired, role); len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// put writes the role to Vault, along with the CA certificate currently held
+// by the Secret or ConfigMap it references.
+func (c *external) put(ctx context.Context, cr *v1alpha1.CertAuthRole) error {
+	role, err := c.certRole(ctx, cr.Spec.ForProvider)
+
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(c.service.PutCertRole(ctx, mount(cr), roleName(cr), role), errPutRole)
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CertAuthRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCertAuthRole)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CertAuthRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCertAuthRole)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CertAuthRole)
+	if !ok {
+		return errors.New(errNotCertAuthRole)
+	}
+
+	return errors.Wrap(c.service.DeleteCertRole(ctx, mount(cr), roleName(cr)), errDeleteRole)
+}
@@ -0,0 +1,223 @@
+package certauthrole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+)
+
+const caCert = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+
+func caConfigMap(pem string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.ConfigMap).Data = map[string]string{"ca.crt": pem}
+		return nil
+	}
+}
+
+func role() *v1alpha1.CertAuthRole {
+	return &v1alpha1.CertAuthRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "ledger",
+		},
+		Spec: v1alpha1.CertAuthRoleSpec{
+			ForProvider: v1alpha1.CertAuthRoleParameters{
+				CertificateConfigMapRef: &v1alpha1.ConfigMapKeySelector{
+					Namespace: "crossplane-system",
+					Name:      "internal-ca",
+					Key:       "ca.crt",
+				},
+				AllowedCommonNames: []string{"ledger.internal"},
+				TokenParameters: v1alpha1.TokenParameters{
+					TokenPolicies: []string{"ledger"},
+					TokenTTL:      &v1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+	}
+}
+
+func TestCertAuthRole_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		path        string
+		err         error
+	}
+
+	current := &clients.CertRole{
+		Certificate:                caCert + "\n",
+		AllowedCommonNames:         []string{"ledger.internal"},
+		AllowedDNSSANs:             []string{},
+		AllowedOrganizationalUnits: []string{},
+		Token: clients.TokenConfig{
+			Policies: []string{"ledger"},
+			TTL:      time.Hour,
+		},
+	}
+
+	cases := map[string]struct {
+		reason      string
+		get         test.MockGetFn
+		deleted     bool
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when Vault has no such role": {
+			get: caConfigMap(caCert),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetCertRole(gomock.Any(), "cert", "ledger").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date while the CA is unchanged": {
+			get: caConfigMap(caCert + "\n"),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/cert/certs/ledger",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetCertRole(gomock.Any(), "cert", "ledger").Return(current, nil).Times(1)
+			},
+		},
+		"should re-apply when the referenced CA rotates": {
+			get: caConfigMap("-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----"),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "CA certificate differs",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/cert/certs/ledger",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetCertRole(gomock.Any(), "cert", "ledger").Return(current, nil).Times(1)
+			},
+		},
+		"should fail when the CA cannot be read": {
+			get: test.NewMockGetFn(errors.New("boom")),
+			want: want{
+				err:  errors.Wrap(errors.Wrapf(errors.New("boom"), common.ErrGetConfigMapKey, "crossplane-system", "internal-ca"), errGetCertificate),
+				path: "auth/cert/certs/ledger",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetCertRole(gomock.Any(), "cert", "ledger").Return(current, nil).Times(1)
+			},
+		},
+		"should not read the CA of a deleted role": {
+			get:     test.NewMockGetFn(errors.New("boom")),
+			deleted: true,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/cert/certs/ledger",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetCertRole(gomock.Any(), "cert", "ledger").Return(current, nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, kube: &test.MockClient{MockGet: testCase.get}}
+			cr := role()
+			if testCase.deleted {
+				cr.SetDeletionTimestamp(&v1.Time{Time: time.Now()})
+			}
+
+			got, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.path, cr.Status.AtProvider.Path); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want path, +got path:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCertAuthRole_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutCertRole(gomock.Any(), "cert", "ledger", clients.CertRole{
+		Certificate:        caCert,
+		AllowedCommonNames: []string{"ledger.internal"},
+		Token: clients.TokenConfig{
+			Policies: []string{"ledger"},
+			TTL:      time.Hour,
+		},
+	}).Return(nil).Times(1)
+
+	e := external{service: mock, kube: &test.MockClient{MockGet: caConfigMap(caCert + "\n")}}
+
+	if _, err := e.Create(context.Background(), role()); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+}
+
+func TestCertAuthRole_CertificateRoles(t *testing.T) {
+	fromSecret := role()
+	fromSecret.SetName("from-secret")
+	fromSecret.Spec.ForProvider.CertificateConfigMapRef = nil
+	fromSecret.Spec.ForProvider.CertificateSecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "internal-ca"},
+		Key:             "ca.crt",
+	}
+
+	kube := &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1alpha1.CertAuthRoleList).Items = []v1alpha1.CertAuthRole{*role(), *fromSecret}
+		return nil
+	}}
+
+	cm := &corev1.ConfigMap{ObjectMeta: v1.ObjectMeta{Namespace: "crossplane-system", Name: "internal-ca"}}
+	got := certificateRoles(kube, configMapReferenced)(cm)
+	want := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "ledger"}}}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("certificateRoles(...): -want, +got:\n%s\n", diff)
+	}
+}
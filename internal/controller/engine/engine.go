@@ -8,13 +8,17 @@ import (
 	"github.com/munditrade/provider-secret/internal/common"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sort"
+	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -30,8 +34,26 @@ const (
 	errErrorGettingEngine = "cannot get path given a engine"
 	errNoSecretRef        = "ProviderConfig does not reference a credentials Secret"
 	errGetSecret          = "cannot get credentials Secret"
+	errTuningEngine       = "cannot tune engine"
+	errRemountEngine      = "cannot remount engine"
+	errRemountStatus      = "cannot get remount status"
+	errRemountFailed      = "remount from %s to %s failed, migration %s"
+	errGetKVConfig        = "cannot get kv configuration"
+	errPutKVConfig        = "cannot write kv configuration"
+	errKVConfigVersion    = "kvConfig only applies to kv engines with options.version 2"
+	errKVDowngrade        = "cannot downgrade kv engine %s from version 2 to version 1"
+	errListSecretPaths    = "cannot list SecretPaths"
+	errListEngine         = "cannot list engine contents"
+	errDeletionBlocked    = "refusing to unmount %s: %s; set spec.forProvider.forceDestroy or the " +
+		v1alpha1.AnnotationKeyForceDestroy + " annotation to delete it anyway"
 
 	errNewClient = "cannot create new Service"
+
+	reasonRemounting      xpv1.ConditionReason = "Remounting"
+	reasonDeletionBlocked xpv1.ConditionReason = "DeletionBlocked"
+
+	kvType        = v1alpha1.EngineKV
+	versionOption = "version"
 )
 
 // Setup adds a controller that reconciles Engine managed resources.
@@ -101,11 +123,258 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{service: svc}, nil
+	return &external{service: svc, kube: c.kube}, nil
 }
 
 type external struct {
 	service common.SecretManager
+	kube    client.Reader
+}
+
+// engineConfig returns the tunable configuration declared by the Engine.
+func engineConfig(params v1alpha1.EngineParameters) common.EngineConfig {
+	config := common.EngineConfig{
+		Description:               params.Description,
+		ListingVisibility:         params.ListingVisibility,
+		AuditNonHMACRequestKeys:   params.AuditNonHMACRequestKeys,
+		PassthroughRequestHeaders: params.PassthroughRequestHeaders,
+		AllowedResponseHeaders:    params.AllowedResponseHeaders,
+		Options:                   common.MountOptions(params),
+	}
+
+	if params.DefaultLeaseTTL != nil {
+		config.DefaultLeaseTTL = params.DefaultLeaseTTL.Duration
+	}
+
+	if params.MaxLeaseTTL != nil {
+		config.MaxLeaseTTL = params.MaxLeaseTTL.Duration
+	}
+
+	return config
+}
+
+// engineMount returns the mount declared by the Engine. Plugin engines mount
+// the plugin they name.
+func engineMount(params v1alpha1.EngineParameters) common.EngineMount {
+	mount := common.EngineMount{
+		Type:                  params.Storage,
+		Config:                engineConfig(params),
+		Local:                 params.Local,
+		SealWrap:              params.SealWrap,
+		ExternalEntropyAccess: params.ExternalEntropyAccess,
+	}
+
+	if params.Plugin != nil {
+		mount.Type = params.Plugin.Name
+		mount.PluginVersion = params.Plugin.Version
+	}
+
+	return mount
+}
+
+// engineDrift compares the configuration of the mount with the one declared
+// by the Engine. Settings left unset on the Engine are not managed, so Vault
+// defaults never count as drift.
+func engineDrift(params v1alpha1.EngineParameters, current *common.EngineConfig) []string {
+	desired := engineConfig(params)
+	drifts := make([]string, 0)
+
+	if desired.Description != "" && desired.Description != current.Description {
+		drifts = append(drifts, "description differs")
+	}
+
+	if params.DefaultLeaseTTL != nil && desired.DefaultLeaseTTL != current.DefaultLeaseTTL {
+		drifts = append(drifts, "default lease TTL differs")
+	}
+
+	if params.MaxLeaseTTL != nil && desired.MaxLeaseTTL != current.MaxLeaseTTL {
+		drifts = append(drifts, "max lease TTL differs")
+	}
+
+	if desired.ListingVisibility != "" && desired.ListingVisibility != current.ListingVisibility {
+		drifts = append(drifts, "listing visibility differs")
+	}
+
+	if len(desired.AuditNonHMACRequestKeys) > 0 && !common.SameSet(desired.AuditNonHMACRequestKeys, current.AuditNonHMACRequestKeys) {
+		drifts = append(drifts, "audit non-HMAC request keys differ")
+	}
+
+	if len(desired.PassthroughRequestHeaders) > 0 && !common.SameSet(desired.PassthroughRequestHeaders, current.PassthroughRequestHeaders) {
+		drifts = append(drifts, "passthrough request headers differ")
+	}
+
+	if len(desired.AllowedResponseHeaders) > 0 && !common.SameSet(desired.AllowedResponseHeaders, current.AllowedResponseHeaders) {
+		drifts = append(drifts, "allowed response headers differ")
+	}
+
+	options := make([]string, 0)
+	for name, value := range desired.Options {
+		if current.Options[name] != value {
+			options = append(options, name)
+		}
+	}
+
+	if len(options) > 0 {
+		sort.Strings(options)
+		drifts = append(drifts, fmt.Sprintf("options %s differ", strings.Join(options, ", ")))
+	}
+
+	return drifts
+}
+
+// kvConfig returns the kv version 2 configuration declared by the Engine.
+func kvConfig(params *v1alpha1.KVConfig) common.KVConfig {
+	config := common.KVConfig{
+		MaxVersions: params.MaxVersions,
+		CASRequired: params.CASRequired,
+	}
+
+	if params.DeleteVersionAfter != nil {
+		config.DeleteVersionAfter = &params.DeleteVersionAfter.Duration
+	}
+
+	return config
+}
+
+// kvDrift compares the configuration of a kv version 2 mount with the one
+// declared by the Engine, ignoring the settings it leaves unset.
+func kvDrift(params *v1alpha1.KVConfig, current *common.KVConfig) []string {
+	desired := kvConfig(params)
+	drifts := make([]string, 0)
+
+	if desired.MaxVersions != nil && (current.MaxVersions == nil || *desired.MaxVersions != *current.MaxVersions) {
+		drifts = append(drifts, "max versions differ")
+	}
+
+	if desired.CASRequired != nil && (current.CASRequired == nil || *desired.CASRequired != *current.CASRequired) {
+		drifts = append(drifts, "cas required differs")
+	}
+
+	if desired.DeleteVersionAfter != nil && (current.DeleteVersionAfter == nil || *desired.DeleteVersionAfter != *current.DeleteVersionAfter) {
+		drifts = append(drifts, "delete version after differs")
+	}
+
+	return drifts
+}
+
+// validate rejects settings that do not apply to the kind of engine.
+func validate(params v1alpha1.EngineParameters) error {
+	if params.KVConfig != nil && (params.Storage != kvType || common.MountOptions(params)[versionOption] != "2") {
+		return errors.New(errKVConfigVersion)
+	}
+
+	return nil
+}
+
+// desiredKVVersion returns the version a kv Engine asks for, which is 1
+// unless its options say otherwise.
+func desiredKVVersion(params v1alpha1.EngineParameters) string {
+	if params.Storage != kvType {
+		return ""
+	}
+
+	if v, ok := common.MountOptions(params)[versionOption]; ok {
+		return v
+	}
+
+	return "1"
+}
+
+// kvDowngrade reports whether the Engine explicitly asks for kv version 1 of
+// a mount that already runs version 2, which Vault cannot do. An Engine that
+// leaves the version unset does not manage it, so adopting a version 2 mount
+// is not a downgrade.
+func kvDowngrade(cr *v1alpha1.Engine) bool {
+	if cr.Spec.ForProvider.Storage != kvType {
+		return false
+	}
+
+	v, ok := common.MountOptions(cr.Spec.ForProvider)[versionOption]
+
+	return ok && v == "1" && cr.Status.AtProvider.KVVersion == "2"
+}
+
+// upgradeDone reports whether an in-place upgrade to kv version 2 is over:
+// the mount reports version 2 and its configuration endpoint answers.
+func (c *external) upgradeDone(ctx context.Context, engine string, mount *common.Engine) bool {
+	if kvVersion(mount) != "2" {
+		return false
+	}
+
+	_, err := c.service.GetKVConfig(ctx, engine)
+
+	return err == nil
+}
+
+// kvVersion returns the version of a kv mount, which is 1 unless its options
+// say otherwise.
+func kvVersion(mount *common.Engine) string {
+	if mount.Type != kvType {
+		return ""
+	}
+
+	if v, ok := mount.Config.Options[versionOption]; ok {
+		return v
+	}
+
+	return "1"
+}
+
+// setObservation records the path and the metadata of the mount in the
+// Engine status.
+func setObservation(cr *v1alpha1.Engine, path string, mount *common.Engine) {
+	o := &cr.Status.AtProvider
+
+	o.Path = path
+	o.Accessor = mount.Accessor
+	o.Type = mount.Type
+	o.PluginVersion = mount.RunningVersion
+	o.UUID = mount.UUID
+	o.KVVersion = kvVersion(mount)
+	o.DefaultLeaseTTL = &metav1.Duration{Duration: mount.Config.DefaultLeaseTTL}
+	o.MaxLeaseTTL = &metav1.Duration{Duration: mount.Config.MaxLeaseTTL}
+	o.Local = mount.Local
+	o.SealWrap = mount.SealWrap
+}
+
+// remounting reports that the mount is being moved to a new path.
+func remounting(r *v1alpha1.EngineRemount) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonRemounting,
+		Message:            fmt.Sprintf("moving mount from %s to %s", r.From, r.To),
+	}
+}
+
+// pollRemount refreshes the status of a remount in progress, and reports
+// whether it is still running.
+func (c *external) pollRemount(ctx context.Context, cr *v1alpha1.Engine) (bool, error) {
+	r := cr.Status.AtProvider.Remount
+	if r == nil || r.Status != v1alpha1.RemountInProgress {
+		return false, nil
+	}
+
+	status, err := c.service.GetRemountStatus(ctx, r.MigrationID)
+
+	if err != nil {
+		return false, errors.Wrap(err, errRemountStatus)
+	}
+
+	r.Status = status
+
+	switch status {
+	case v1alpha1.RemountSuccess:
+		cr.Status.AtProvider.Path = r.To
+	case v1alpha1.RemountFailure:
+		return false, errors.Errorf(errRemountFailed, r.From, r.To, r.MigrationID)
+	default:
+		cr.SetConditions(remounting(r))
+		return true, nil
+	}
+
+	return false, nil
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -114,23 +383,123 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotEngine)
 	}
 
-	engine := cr.ObjectMeta.Name
+	// A deleted Engine is only looked up, so that a spec Vault would reject
+	// does not keep it from being unmounted.
+	if !meta.WasDeleted(cr) {
+		if err := validate(cr.Spec.ForProvider); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	running, err := c.pollRemount(ctx, cr)
 
-	exist, err := c.service.ExistEngine(ctx, engine)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if running {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	desired := common.MountPath(cr)
+	engine := common.MountedPath(cr)
+
+	mount, err := c.service.GetEngine(ctx, engine)
 
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errErrorGettingEngine)
 	}
 
-	// These fmt statements should be removed in the real implementation.
-	fmt.Printf("Observing: %+v", cr)
+	// The mount is gone from its previous path, so there is nothing to move.
+	if mount == nil && engine != desired {
+		engine = desired
+		mount, err = c.service.GetEngine(ctx, engine)
 
-	if exist {
-		cr.SetConditions(xpv1.Available())
+		if err != nil {
+			return managed.ExternalObservation{}, errors.New(errErrorGettingEngine)
+		}
+	}
+
+	if mount == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	setObservation(cr, engine, mount)
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
 	}
 
+	// Update refuses the downgrade, which surfaces it on the Engine.
+	if kvDowngrade(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              "kv version cannot change from 2 to 1",
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	if cr.GetCondition(v1alpha1.TypeUpgrading).Status == corev1.ConditionTrue {
+		if !c.upgradeDone(ctx, engine, mount) {
+			cr.SetConditions(xpv1.Unavailable())
+
+			return managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}, nil
+		}
+
+		cr.SetConditions(v1alpha1.UpgradeComplete())
+	}
+
+	if engine != desired {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              fmt.Sprintf("mount path changes from %s to %s", engine, desired),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	drifts := engineDrift(cr.Spec.ForProvider, &mount.Config)
+
+	if cr.Spec.ForProvider.KVConfig != nil && kvVersion(mount) == "2" {
+		kv, err := c.service.GetKVConfig(ctx, engine)
+
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetKVConfig)
+		}
+
+		drifts = append(drifts, kvDrift(cr.Spec.ForProvider.KVConfig, kv)...)
+	}
+
+	if len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
 	return managed.ExternalObservation{
-		ResourceExists:    exist,
+		ResourceExists:    true,
 		ResourceUpToDate:  true,
 		ConnectionDetails: managed.ConnectionDetails{},
 	}, nil
@@ -142,13 +511,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotEngine)
 	}
 
-	fmt.Printf("Creating: %+v", cr)
+	engine := common.MountPath(cr)
 
-	engine := cr.ObjectMeta.Name
-	storage := cr.Spec.ForProvider.Storage
-	opts := cr.Spec.ForProvider.Options
-
-	err := c.service.CreateEngine(ctx, engine, storage, opts)
+	err := c.service.CreateEngine(ctx, engine, engineMount(cr.Spec.ForProvider))
 
 	if err != nil {
 		return managed.ExternalCreation{}, errors.New(errCreatingEngine)
@@ -159,33 +524,157 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}, nil
 }
 
+// remount moves the mount to its new path, keeping its secrets, and tracks
+// the operation in the Engine status.
+func (c *external) remount(ctx context.Context, cr *v1alpha1.Engine, from string, to string) error {
+	id, err := c.service.RemountEngine(ctx, from, to)
+
+	if err != nil {
+		return errors.Wrap(err, errRemountEngine)
+	}
+
+	r := &v1alpha1.EngineRemount{From: from, To: to, MigrationID: id, Status: v1alpha1.RemountInProgress}
+	cr.Status.AtProvider.Remount = r
+
+	if id == "" {
+		r.Status = v1alpha1.RemountSuccess
+		cr.Status.AtProvider.Path = to
+		return nil
+	}
+
+	cr.SetConditions(remounting(r))
+
+	return nil
+}
+
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mg.(*v1alpha1.Engine)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotEngine)
 	}
 
-	fmt.Printf("Updating: %+v", cr)
+	engine := common.MountPath(cr)
+
+	if from := common.MountedPath(cr); from != engine {
+		return managed.ExternalUpdate{}, c.remount(ctx, cr, from, engine)
+	}
+
+	if kvDowngrade(cr) {
+		return managed.ExternalUpdate{}, errors.Errorf(errKVDowngrade, engine)
+	}
+
+	if err := c.service.TuneEngine(ctx, engine, engineConfig(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errTuningEngine)
+	}
+
+	// Tuning the version option of a kv version 1 mount starts its upgrade,
+	// which Vault runs in the background.
+	if cr.Status.AtProvider.KVVersion == "1" && desiredKVVersion(cr.Spec.ForProvider) == "2" {
+		cr.SetConditions(v1alpha1.Upgrading(fmt.Sprintf("upgrading %s to kv version 2", engine)), xpv1.Unavailable())
+
+		return managed.ExternalUpdate{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// The configuration endpoint only exists once the mount runs version 2.
+	if params := cr.Spec.ForProvider.KVConfig; params != nil && cr.Status.AtProvider.KVVersion == "2" {
+		if err := c.service.PutKVConfig(ctx, engine, kvConfig(params)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutKVConfig)
+		}
+	}
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
 	}, nil
 }
 
+// forceDestroy reports whether the Engine is unmounted on deletion regardless
+// of its contents and references.
+func forceDestroy(cr *v1alpha1.Engine) bool {
+	return cr.Spec.ForProvider.ForceDestroy || cr.GetAnnotations()[v1alpha1.AnnotationKeyForceDestroy] == "true"
+}
+
+// deletionBlocked reports why the mount is kept despite the Engine being
+// deleted.
+func deletionBlocked(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonDeletionBlocked,
+		Message:            err.Error(),
+	}
+}
+
+// guardDeletion refuses to unmount an engine still referenced by SecretPaths
+// or, for kv engines, still holding secrets.
+func (c *external) guardDeletion(ctx context.Context, cr *v1alpha1.Engine, engine string) error {
+	paths := &v1alpha1.SecretPathList{}
+	if err := c.kube.List(ctx, paths); err != nil {
+		return errors.Wrap(err, errListSecretPaths)
+	}
+
+	refs := make([]string, 0)
+	for _, p := range paths.Items {
+		if p.Spec.ForProvider.Engine == cr.GetName() {
+			refs = append(refs, p.GetName())
+		}
+	}
+
+	if len(refs) > 0 {
+		sort.Strings(refs)
+		return errors.Errorf(errDeletionBlocked, engine, fmt.Sprintf("it is referenced by SecretPaths %s", strings.Join(refs, ", ")))
+	}
+
+	if cr.Spec.ForProvider.Storage != kvType {
+		return nil
+	}
+
+	// List the mount the way Vault serves it: a kv mount without a version
+	// option is version 1, whatever its spec asks for.
+	version := cr.Status.AtProvider.KVVersion
+	if version == "" {
+		version = desiredKVVersion(cr.Spec.ForProvider)
+	}
+
+	keys, err := c.service.ListEngine(ctx, engine, map[string]string{versionOption: version})
+
+	if err != nil {
+		return errors.Wrap(err, errListEngine)
+	}
+
+	if len(keys) > 0 {
+		return errors.Errorf(errDeletionBlocked, engine, fmt.Sprintf("it still holds %d keys", len(keys)))
+	}
+
+	return nil
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.Engine)
 	if !ok {
 		return errors.New(errNotEngine)
 	}
 
-	engine := cr.ObjectMeta.Name
+	engine := common.MountedPath(cr)
 
-	exist, _ := c.service.ExistEngine(ctx, engine)
+	exist, err := c.service.ExistEngine(ctx, engine)
 
-	if exist {
-		fmt.Printf("Deleting: %+v", cr)
-		return c.service.DeleteEngine(ctx, engine)
+	if err != nil {
+		return errors.Wrap(err, errErrorGettingEngine)
 	}
 
-	return nil
+	if !exist {
+		return nil
+	}
+
+	if !forceDestroy(cr) {
+		if err := c.guardDeletion(ctx, cr, engine); err != nil {
+			cr.SetConditions(deletionBlocked(err))
+			return err
+		}
+	}
+
+	return c.service.DeleteEngine(ctx, engine)
 }
@@ -8,9 +8,13 @@ import (
 	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
 	"github.com/munditrade/provider-secret/internal/common"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"testing"
+	"time"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
@@ -52,7 +56,7 @@ func TestEngine_Observe(t *testing.T) {
 				err: nil,
 			},
 			prepareMock: func(m *common.MockSecretManager) {
-				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(false, nil).AnyTimes()
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(nil, nil).AnyTimes()
 			},
 		},
 		"when engine does not exist must return true in ResourceExist": {
@@ -73,10 +77,49 @@ func TestEngine_Observe(t *testing.T) {
 				err: nil,
 			},
 			prepareMock: func(m *common.MockSecretManager) {
-				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).AnyTimes()
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(&common.Engine{}, nil).AnyTimes()
+			},
+		},
+		"when the mount configuration differs should not be up to date": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					Spec: v1alpha1.EngineSpec{
+						ForProvider: v1alpha1.EngineParameters{
+							Storage:           "kv",
+							Options:           map[string]string{"version": "2"},
+							Description:       "team secrets",
+							MaxLeaseTTL:       &v1.Duration{Duration: time.Hour},
+							ListingVisibility: "hidden",
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Name: "test-engine",
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "max lease TTL differs; options version differ",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(&common.Engine{
+					Config: common.EngineConfig{
+						Description:       "team secrets",
+						DefaultLeaseTTL:   768 * time.Hour,
+						MaxLeaseTTL:       768 * time.Hour,
+						ListingVisibility: "hidden",
+						Options:           map[string]string{"version": "1"},
+					},
+				}, nil).AnyTimes()
 			},
 		},
-		"when ExistEngine function returns an error should return errErrorGettingEngine err": {
+		"when GetEngine function returns an error should return errErrorGettingEngine err": {
 			args: args{
 				ctx: context.Background(),
 				mg: &v1alpha1.Engine{
@@ -90,7 +133,7 @@ func TestEngine_Observe(t *testing.T) {
 				err: errors.New(errErrorGettingEngine),
 			},
 			prepareMock: func(m *common.MockSecretManager) {
-				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(false, errors.New("boom")).
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(nil, errors.New("boom")).
 					AnyTimes()
 			},
 		},
@@ -117,6 +160,52 @@ func TestEngine_Observe(t *testing.T) {
 	}
 }
 
+func TestEngine_ObserveStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := common.NewMockSecretManager(ctrl)
+	mock.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(&common.Engine{
+		Config: common.EngineConfig{
+			DefaultLeaseTTL: 768 * time.Hour,
+			MaxLeaseTTL:     768 * time.Hour,
+			Options:         map[string]string{"version": "2"},
+		},
+		Accessor:       "kv_1b2c3d4e",
+		Type:           "kv",
+		RunningVersion: "v0.13.0+builtin",
+		UUID:           "6b7d3a4e-0c5e-2a1f-9d8e-1c2b3a4d5e6f",
+		SealWrap:       true,
+	}, nil).Times(1)
+
+	e := external{service: mock}
+	cr := &v1alpha1.Engine{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-engine",
+		},
+	}
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+
+	want := v1alpha1.EngineObservation{
+		Path:            "test-engine",
+		Accessor:        "kv_1b2c3d4e",
+		Type:            "kv",
+		PluginVersion:   "v0.13.0+builtin",
+		UUID:            "6b7d3a4e-0c5e-2a1f-9d8e-1c2b3a4d5e6f",
+		KVVersion:       "2",
+		DefaultLeaseTTL: &v1.Duration{Duration: 768 * time.Hour},
+		MaxLeaseTTL:     &v1.Duration{Duration: 768 * time.Hour},
+		SealWrap:        true,
+	}
+
+	if diff := cmp.Diff(want, cr.Status.AtProvider); diff != "" {
+		t.Errorf("e.Observe(...): -want status, +got status:\n%s\n", diff)
+	}
+}
+
 func TestEngine_Create(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -160,11 +249,142 @@ func TestEngine_Create(t *testing.T) {
 				err: nil,
 			},
 			prepareMock: func(m *common.MockSecretManager) {
-				m.EXPECT().CreateEngine(gomock.Any(), "test-engine", "kv", map[string]string{
-					"version": "1",
+				m.EXPECT().CreateEngine(gomock.Any(), "test-engine", common.EngineMount{
+					Type:   "kv",
+					Config: common.EngineConfig{Options: map[string]string{"version": "1"}},
 				}).Return(nil).AnyTimes()
 			},
 		},
+		"should create the engine at its external name": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					Spec: v1alpha1.EngineSpec{
+						ForProvider: v1alpha1.EngineParameters{
+							Storage: "kv",
+							Options: map[string]string{
+								"version": "2",
+							},
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Name: "test-engine",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "team-a/kv",
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().CreateEngine(gomock.Any(), "team-a/kv", common.EngineMount{
+					Type:   "kv",
+					Config: common.EngineConfig{Options: map[string]string{"version": "2"}},
+				}).Return(nil).Times(1)
+			},
+		},
+		"should prefer the path parameter over the external name": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					Spec: v1alpha1.EngineSpec{
+						ForProvider: v1alpha1.EngineParameters{
+							Storage: "kv",
+							Path:    "/apps/prod/secrets/",
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Name: "test-engine",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "test-engine",
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().CreateEngine(gomock.Any(), "apps/prod/secrets", gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		"should prefer the typed kv version over the passthrough options": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					Spec: v1alpha1.EngineSpec{
+						ForProvider: v1alpha1.EngineParameters{
+							Storage:     "kv",
+							Options:     map[string]string{"version": "1"},
+							KV:          &v1alpha1.KVOptions{Version: "2"},
+							Description: "team secrets",
+							MaxLeaseTTL: &v1.Duration{Duration: time.Hour},
+							SealWrap:    true,
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Name: "test-engine",
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().CreateEngine(gomock.Any(), "test-engine", common.EngineMount{
+					Type: "kv",
+					Config: common.EngineConfig{
+						Description: "team secrets",
+						MaxLeaseTTL: time.Hour,
+						Options:     map[string]string{"version": "2"},
+					},
+					SealWrap: true,
+				}).Return(nil).Times(1)
+			},
+		},
+		"should mount the plugin a plugin engine names": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					Spec: v1alpha1.EngineSpec{
+						ForProvider: v1alpha1.EngineParameters{
+							Storage:               "plugin",
+							Plugin:                &v1alpha1.PluginOptions{Name: "vault-plugin-secrets-custom", Version: "v1.2.0"},
+							Options:               map[string]string{"region": "eu"},
+							Local:                 true,
+							ExternalEntropyAccess: true,
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Name: "custom",
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().CreateEngine(gomock.Any(), "custom", common.EngineMount{
+					Type:                  "vault-plugin-secrets-custom",
+					PluginVersion:         "v1.2.0",
+					Config:                common.EngineConfig{Options: map[string]string{"region": "eu"}},
+					Local:                 true,
+					ExternalEntropyAccess: true,
+				}).Return(nil).Times(1)
+			},
+		},
 		"when engine creation fails should fail all": {
 			args: args{
 				ctx: context.Background(),
@@ -187,8 +407,9 @@ func TestEngine_Create(t *testing.T) {
 				err: errors.New(errCreatingEngine),
 			},
 			prepareMock: func(m *common.MockSecretManager) {
-				m.EXPECT().CreateEngine(gomock.Any(), "test-engine", "kv", map[string]string{
-					"version": "1",
+				m.EXPECT().CreateEngine(gomock.Any(), "test-engine", common.EngineMount{
+					Type:   "kv",
+					Config: common.EngineConfig{Options: map[string]string{"version": "1"}},
 				}).Return(errors.New("boom")).AnyTimes()
 			},
 		},
@@ -215,7 +436,7 @@ func TestEngine_Create(t *testing.T) {
 	}
 }
 
-func TestEngine_Delete(t *testing.T) {
+func TestEngine_Update(t *testing.T) {
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
@@ -224,53 +445,172 @@ func TestEngine_Delete(t *testing.T) {
 	type prepareMock func(m *common.MockSecretManager)
 
 	type want struct {
-		o   managed.ExternalCreation
+		o   managed.ExternalUpdate
 		err error
 	}
 
+	engine := &v1alpha1.Engine{
+		Spec: v1alpha1.EngineSpec{
+			ForProvider: v1alpha1.EngineParameters{
+				Storage:                 "kv",
+				Options:                 map[string]string{"version": "2"},
+				DefaultLeaseTTL:         &v1.Duration{Duration: 30 * time.Minute},
+				AuditNonHMACRequestKeys: []string{"path"},
+			},
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-engine",
+		},
+	}
+
+	config := common.EngineConfig{
+		DefaultLeaseTTL:         30 * time.Minute,
+		AuditNonHMACRequestKeys: []string{"path"},
+		Options:                 map[string]string{"version": "2"},
+	}
+
 	cases := map[string]struct {
 		reason      string
 		args        args
 		want        want
 		prepareMock prepareMock
 	}{
-		"when engine exists should be deleted": {
+		"should tune the mount": {
 			args: args{
 				ctx: context.Background(),
-				mg: &v1alpha1.Engine{
-					ObjectMeta: v1.ObjectMeta{
-						Name: "test-engine",
-					},
-				},
+				mg:  engine,
 			},
 			want: want{
-				o: managed.ExternalCreation{
+				o: managed.ExternalUpdate{
 					ConnectionDetails: managed.ConnectionDetails{},
 				},
 				err: nil,
 			},
 			prepareMock: func(m *common.MockSecretManager) {
-				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).AnyTimes()
-				m.EXPECT().DeleteEngine(gomock.Any(), "test-engine").Return(nil).AnyTimes()
+				m.EXPECT().TuneEngine(gomock.Any(), "test-engine", config).Return(nil).Times(1)
 			},
 		},
-		"when engine does not exist should not return an error": {
+		"when tuning fails should return errTuningEngine err": {
 			args: args{
 				ctx: context.Background(),
-				mg: &v1alpha1.Engine{
-					ObjectMeta: v1.ObjectMeta{
-						Name: "test-engine",
+				mg:  engine,
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errors.New("boom"), errTuningEngine),
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().TuneEngine(gomock.Any(), "test-engine", config).Return(errors.New("boom")).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := common.NewMockSecretManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			got, err := e.Update(testCase.args.ctx, testCase.args.mg)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEngine_KVConfig(t *testing.T) {
+	type prepareMock func(m *common.MockSecretManager)
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	maxVersions := 5
+	casRequired := true
+	vaultMaxVersions := 10
+	vaultCASRequired := true
+	vaultDeleteVersionAfter := time.Duration(0)
+
+	kvEngine := func(version string) *v1alpha1.Engine {
+		return &v1alpha1.Engine{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "test-engine",
+			},
+			Spec: v1alpha1.EngineSpec{
+				ForProvider: v1alpha1.EngineParameters{
+					Storage: "kv",
+					Options: map[string]string{"version": version},
+					KVConfig: &v1alpha1.KVConfig{
+						MaxVersions: &maxVersions,
+						CASRequired: &casRequired,
 					},
 				},
 			},
+		}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		cr          *v1alpha1.Engine
+		want        want
+		prepareMock prepareMock
+	}{
+		"when the kv configuration differs should not be up to date": {
+			cr: kvEngine("2"),
 			want: want{
-				o: managed.ExternalCreation{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "max versions differ",
 					ConnectionDetails: managed.ConnectionDetails{},
 				},
-				err: nil,
 			},
 			prepareMock: func(m *common.MockSecretManager) {
-				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(false, nil).AnyTimes()
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(&common.Engine{
+					Type:   "kv",
+					Config: common.EngineConfig{Options: map[string]string{"version": "2"}},
+				}, nil).Times(1)
+				m.EXPECT().GetKVConfig(gomock.Any(), "test-engine").Return(&common.KVConfig{
+					MaxVersions:        &vaultMaxVersions,
+					CASRequired:        &vaultCASRequired,
+					DeleteVersionAfter: &vaultDeleteVersionAfter,
+				}, nil).Times(1)
+			},
+		},
+		"when the engine is not kv version 2 should reject the kv configuration": {
+			cr: kvEngine("1"),
+			want: want{
+				err: errors.New(errKVConfigVersion),
+			},
+			prepareMock: func(m *common.MockSecretManager) {},
+		},
+		"when the engine is deleted should not reject the kv configuration": {
+			cr: func() *v1alpha1.Engine {
+				cr := kvEngine("1")
+				cr.SetDeletionTimestamp(&v1.Time{Time: time.Now()})
+				return cr
+			}(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(&common.Engine{
+					Type:   "kv",
+					Config: common.EngineConfig{Options: map[string]string{"version": "2"}},
+				}, nil).Times(1)
 			},
 		},
 	}
@@ -284,6 +624,597 @@ func TestEngine_Delete(t *testing.T) {
 			mock := common.NewMockSecretManager(ctrl)
 			testCase.prepareMock(mock)
 			e := external{service: mock}
+			got, err := e.Observe(context.Background(), testCase.cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+
+	t.Run("should write the kv configuration on update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := common.NewMockSecretManager(ctrl)
+		mock.EXPECT().TuneEngine(gomock.Any(), "test-engine", gomock.Any()).Return(nil).Times(1)
+		mock.EXPECT().PutKVConfig(gomock.Any(), "test-engine", common.KVConfig{
+			MaxVersions: &maxVersions,
+			CASRequired: &casRequired,
+		}).Return(nil).Times(1)
+
+		cr := kvEngine("2")
+		cr.Status.AtProvider.KVVersion = "2"
+		e := external{service: mock}
+
+		if _, err := e.Update(context.Background(), cr); err != nil {
+			t.Errorf("e.Update(...): unexpected error: %v", err)
+		}
+	})
+}
+
+func TestEngine_Upgrade(t *testing.T) {
+	type prepareMock func(m *common.MockSecretManager)
+
+	type want struct {
+		o         managed.ExternalObservation
+		upgrading corev1.ConditionStatus
+		err       error
+	}
+
+	kvEngine := func(version string, upgrading bool) *v1alpha1.Engine {
+		cr := &v1alpha1.Engine{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "test-engine",
+			},
+			Spec: v1alpha1.EngineSpec{
+				ForProvider: v1alpha1.EngineParameters{
+					Storage: "kv",
+					Options: map[string]string{"version": version},
+				},
+			},
+		}
+
+		if upgrading {
+			cr.SetConditions(v1alpha1.Upgrading("upgrading test-engine to kv version 2"))
+		}
+
+		return cr
+	}
+
+	mount := func(version string) *common.Engine {
+		return &common.Engine{
+			Type:   "kv",
+			Config: common.EngineConfig{Options: map[string]string{"version": version}},
+		}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		cr          *v1alpha1.Engine
+		want        want
+		prepareMock prepareMock
+	}{
+		"when the upgrade is running should wait for it": {
+			cr: kvEngine("2", true),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				upgrading: corev1.ConditionTrue,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(mount("2"), nil).Times(1)
+				m.EXPECT().GetKVConfig(gomock.Any(), "test-engine").Return(nil, errors.New("upgrading")).Times(1)
+			},
+		},
+		"when the upgrade is over should complete it": {
+			cr: kvEngine("2", true),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				upgrading: corev1.ConditionFalse,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(mount("2"), nil).Times(1)
+				m.EXPECT().GetKVConfig(gomock.Any(), "test-engine").Return(&common.KVConfig{}, nil).Times(1)
+			},
+		},
+		"when the engine asks for version 1 of a version 2 mount should report the downgrade": {
+			cr: kvEngine("1", false),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "kv version cannot change from 2 to 1",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				upgrading: corev1.ConditionUnknown,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(mount("2"), nil).Times(1)
+			},
+		},
+		"when the engine leaves the version unset should adopt a version 2 mount": {
+			cr: &v1alpha1.Engine{
+				ObjectMeta: v1.ObjectMeta{Name: "test-engine"},
+				Spec: v1alpha1.EngineSpec{
+					ForProvider: v1alpha1.EngineParameters{Storage: "kv"},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				upgrading: corev1.ConditionUnknown,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(mount("2"), nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := common.NewMockSecretManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			got, err := e.Observe(context.Background(), testCase.cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.upgrading, testCase.cr.GetCondition(v1alpha1.TypeUpgrading).Status); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want upgrading, +got upgrading:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+
+	t.Run("should start the upgrade on update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := common.NewMockSecretManager(ctrl)
+		mock.EXPECT().TuneEngine(gomock.Any(), "test-engine", gomock.Any()).Return(nil).Times(1)
+
+		cr := kvEngine("2", false)
+		cr.Status.AtProvider.KVVersion = "1"
+		e := external{service: mock}
+
+		if _, err := e.Update(context.Background(), cr); err != nil {
+			t.Fatalf("e.Update(...): unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(v1alpha1.TypeUpgrading).Status); diff != "" {
+			t.Errorf("e.Update(...): -want upgrading, +got upgrading:\n%s\n", diff)
+		}
+	})
+
+	t.Run("should reject the downgrade on update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := common.NewMockSecretManager(ctrl)
+
+		cr := kvEngine("1", false)
+		cr.Status.AtProvider.KVVersion = "2"
+		e := external{service: mock}
+
+		_, err := e.Update(context.Background(), cr)
+		if diff := cmp.Diff(errors.Errorf(errKVDowngrade, "test-engine"), err, test.EquateErrors()); diff != "" {
+			t.Errorf("e.Update(...): -want error, +got error:\n%s\n", diff)
+		}
+	})
+}
+
+func TestEngine_Remount(t *testing.T) {
+	type prepareMock func(m *common.MockSecretManager)
+
+	type want struct {
+		o       managed.ExternalObservation
+		path    string
+		remount *v1alpha1.EngineRemount
+		err     error
+	}
+
+	moved := func(remount *v1alpha1.EngineRemount) *v1alpha1.Engine {
+		return &v1alpha1.Engine{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "test-engine",
+				Annotations: map[string]string{
+					meta.AnnotationKeyExternalName: "team-a/kv",
+				},
+			},
+			Status: v1alpha1.EngineStatus{
+				AtProvider: v1alpha1.EngineObservation{
+					Path:    "test-engine",
+					Remount: remount,
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		cr          *v1alpha1.Engine
+		want        want
+		prepareMock prepareMock
+	}{
+		"when the mount path changes should observe the mount at its previous path": {
+			cr: moved(nil),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "mount path changes from test-engine to team-a/kv",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "test-engine",
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(&common.Engine{}, nil).Times(1)
+			},
+		},
+		"when the previous mount is gone should observe the new path": {
+			cr: moved(nil),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "test-engine",
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetEngine(gomock.Any(), "test-engine").Return(nil, nil).Times(1)
+				m.EXPECT().GetEngine(gomock.Any(), "team-a/kv").Return(nil, nil).Times(1)
+			},
+		},
+		"when a remount is in progress should wait for it": {
+			cr: moved(&v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", MigrationID: "m-1", Status: v1alpha1.RemountInProgress}),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path:    "test-engine",
+				remount: &v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", MigrationID: "m-1", Status: v1alpha1.RemountInProgress},
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetRemountStatus(gomock.Any(), "m-1").Return(v1alpha1.RemountInProgress, nil).Times(1)
+			},
+		},
+		"when a remount succeeds should observe the new path": {
+			cr: moved(&v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", MigrationID: "m-1", Status: v1alpha1.RemountInProgress}),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path:    "team-a/kv",
+				remount: &v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", MigrationID: "m-1", Status: v1alpha1.RemountSuccess},
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetRemountStatus(gomock.Any(), "m-1").Return(v1alpha1.RemountSuccess, nil).Times(1)
+				m.EXPECT().GetEngine(gomock.Any(), "team-a/kv").Return(&common.Engine{}, nil).Times(1)
+			},
+		},
+		"when a remount fails should return an error": {
+			cr: moved(&v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", MigrationID: "m-1", Status: v1alpha1.RemountInProgress}),
+			want: want{
+				o:       managed.ExternalObservation{},
+				path:    "test-engine",
+				remount: &v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", MigrationID: "m-1", Status: v1alpha1.RemountFailure},
+				err:     errors.Errorf(errRemountFailed, "test-engine", "team-a/kv", "m-1"),
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().GetRemountStatus(gomock.Any(), "m-1").Return(v1alpha1.RemountFailure, nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := common.NewMockSecretManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			got, err := e.Observe(context.Background(), testCase.cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.path, testCase.cr.Status.AtProvider.Path); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want path, +got path:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.remount, testCase.cr.Status.AtProvider.Remount); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want remount, +got remount:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEngine_UpdateRemount(t *testing.T) {
+	cases := map[string]struct {
+		reason      string
+		migrationID string
+		want        *v1alpha1.EngineRemount
+		path        string
+	}{
+		"should track an asynchronous remount": {
+			migrationID: "m-1",
+			want:        &v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", MigrationID: "m-1", Status: v1alpha1.RemountInProgress},
+			path:        "test-engine",
+		},
+		"should complete a synchronous remount": {
+			want: &v1alpha1.EngineRemount{From: "test-engine", To: "team-a/kv", Status: v1alpha1.RemountSuccess},
+			path: "team-a/kv",
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := common.NewMockSecretManager(ctrl)
+			mock.EXPECT().RemountEngine(gomock.Any(), "test-engine", "team-a/kv").Return(testCase.migrationID, nil).Times(1)
+			e := external{service: mock}
+			cr := &v1alpha1.Engine{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "test-engine",
+					Annotations: map[string]string{
+						meta.AnnotationKeyExternalName: "team-a/kv",
+					},
+				},
+				Status: v1alpha1.EngineStatus{
+					AtProvider: v1alpha1.EngineObservation{Path: "test-engine"},
+				},
+			}
+
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): unexpected error: %v", testCase.reason, err)
+			}
+			if diff := cmp.Diff(testCase.want, cr.Status.AtProvider.Remount); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want remount, +got remount:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.path, cr.Status.AtProvider.Path); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want path, +got path:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEngine_Delete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type prepareMock func(m *common.MockSecretManager)
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	referencing := []v1alpha1.SecretPath{
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "other-path"},
+			Spec:       v1alpha1.SecretPathSpec{ForProvider: v1alpha1.SecretPathParameters{Engine: "other-engine"}},
+		},
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "dev-path"},
+			Spec:       v1alpha1.SecretPathSpec{ForProvider: v1alpha1.SecretPathParameters{Engine: "test-engine"}},
+		},
+	}
+
+	kvEngine := func(annotations map[string]string, force bool) *v1alpha1.Engine {
+		return &v1alpha1.Engine{
+			ObjectMeta: v1.ObjectMeta{
+				Name:        "test-engine",
+				Annotations: annotations,
+			},
+			Spec: v1alpha1.EngineSpec{
+				ForProvider: v1alpha1.EngineParameters{
+					Storage:      "kv",
+					Options:      map[string]string{"version": "2"},
+					ForceDestroy: force,
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		args        args
+		paths       []v1alpha1.SecretPath
+		want        want
+		prepareMock prepareMock
+	}{
+		"when engine exists should be deleted": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "test-engine",
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).AnyTimes()
+				m.EXPECT().DeleteEngine(gomock.Any(), "test-engine").Return(nil).AnyTimes()
+			},
+		},
+		"when engine is referenced by a SecretPath should refuse to delete it": {
+			args: args{
+				ctx: context.Background(),
+				mg:  kvEngine(nil, false),
+			},
+			paths: referencing,
+			want: want{
+				err: errors.Errorf(errDeletionBlocked, "test-engine", "it is referenced by SecretPaths dev-path"),
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).Times(1)
+			},
+		},
+		"when engine holds secrets should refuse to delete it": {
+			args: args{
+				ctx: context.Background(),
+				mg:  kvEngine(nil, false),
+			},
+			want: want{
+				err: errors.Errorf(errDeletionBlocked, "test-engine", "it still holds 2 keys"),
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).Times(1)
+				m.EXPECT().ListEngine(gomock.Any(), "test-engine", map[string]string{"version": "2"}).
+					Return([]string{"dev", "prod/"}, nil).Times(1)
+			},
+		},
+		"when a kv version 1 engine holds secrets should refuse to delete it": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					ObjectMeta: v1.ObjectMeta{Name: "test-engine"},
+					Spec: v1alpha1.EngineSpec{
+						ForProvider: v1alpha1.EngineParameters{Storage: "kv"},
+					},
+					Status: v1alpha1.EngineStatus{
+						AtProvider: v1alpha1.EngineObservation{KVVersion: "1"},
+					},
+				},
+			},
+			want: want{
+				err: errors.Errorf(errDeletionBlocked, "test-engine", "it still holds 1 keys"),
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).Times(1)
+				m.EXPECT().ListEngine(gomock.Any(), "test-engine", map[string]string{"version": "1"}).
+					Return([]string{"dev"}, nil).Times(1)
+			},
+		},
+		"when the engine cannot be read should not unmount it": {
+			args: args{
+				ctx: context.Background(),
+				mg:  kvEngine(nil, false),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("boom"), errErrorGettingEngine),
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(false, errors.New("boom")).Times(1)
+			},
+		},
+		"when engine is empty and unreferenced should be deleted": {
+			args: args{
+				ctx: context.Background(),
+				mg:  kvEngine(nil, false),
+			},
+			paths: referencing[:1],
+			want: want{
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).Times(1)
+				m.EXPECT().ListEngine(gomock.Any(), "test-engine", gomock.Any()).Return(nil, nil).Times(1)
+				m.EXPECT().DeleteEngine(gomock.Any(), "test-engine").Return(nil).Times(1)
+			},
+		},
+		"when forceDestroy is set should delete a referenced engine": {
+			args: args{
+				ctx: context.Background(),
+				mg:  kvEngine(nil, true),
+			},
+			paths: referencing,
+			want: want{
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).Times(1)
+				m.EXPECT().DeleteEngine(gomock.Any(), "test-engine").Return(nil).Times(1)
+			},
+		},
+		"when the force-destroy annotation is set should delete a referenced engine": {
+			args: args{
+				ctx: context.Background(),
+				mg:  kvEngine(map[string]string{v1alpha1.AnnotationKeyForceDestroy: "true"}, false),
+			},
+			paths: referencing,
+			want: want{
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(true, nil).Times(1)
+				m.EXPECT().DeleteEngine(gomock.Any(), "test-engine").Return(nil).Times(1)
+			},
+		},
+		"when engine does not exist should not return an error": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Engine{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "test-engine",
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager) {
+				m.EXPECT().ExistEngine(gomock.Any(), "test-engine").Return(false, nil).AnyTimes()
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := common.NewMockSecretManager(ctrl)
+			testCase.prepareMock(mock)
+			kube := &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+				obj.(*v1alpha1.SecretPathList).Items = testCase.paths
+				return nil
+			}}
+			e := external{service: mock, kube: kube}
 			err := e.Delete(testCase.args.ctx, testCase.args.mg)
 
 			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,369 @@
+package jwtauthconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotJWTAuthConfig = "managed resource is not a JWTAuthConfig custom resource"
+	errTrackPCUsage     = "cannot track ProviderConfig usage"
+	errGetPC            = "cannot get ProviderConfig"
+	errNoSecretRef      = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret        = "cannot get credentials Secret"
+	errGetClientSecret  = "cannot get OIDC client secret"
+	errGetConfig        = "cannot get jwt auth configuration"
+	errPutConfig        = "cannot write jwt auth configuration"
+
+	errNewClient = "cannot create new Service"
+
+	defaultMount = v1alpha1.AuthJWT
+)
+
+// Setup adds a controller that reconciles JWTAuthConfig managed resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.JWTAuthConfigGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.JWTAuthConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-jwtauthconfig", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.JWTAuthConfig{}).
+			Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(secretConfigs(mgr.GetClient()))).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+// secretConfigs maps a Secret to the JWTAuthConfigs reading their OIDC client
+// secret from it, so that rotating the client secret re-applies them.
+func secretConfigs(kube client.Reader) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		configs := &v1alpha1.JWTAuthConfigList{}
+		if err := kube.List(context.TODO(), configs); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0)
+
+		for i := range configs.Items {
+			ref := configs.Items[i].Spec.ForProvider.OIDCClientSecretSecretRef
+
+			if ref != nil && ref.Name == obj.GetName() && ref.Namespace == obj.GetNamespace() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: configs.Items[i].GetName()}})
+			}
+		}
+
+		return requests
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.JWTAuthConfig)
+	if !ok {
+		return nil, errors.New(errNotJWTAuthConfig)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc, kube: c.kube}, nil
+}
+
+type external struct {
+	service clients.AuthManager
+	kube    client.Reader
+}
+
+// mount returns the path the jwt or oidc auth method is enabled at.
+func mount(cr *v1alpha1.JWTAuthConfig) string {
+	if m := strings.Trim(cr.Spec.ForProvider.Mount, "/"); m != "" {
+		return m
+	}
+
+	return defaultMount
+}
+
+// jwtConfig returns the configuration declared by the JWTAuthConfig, with the
+// OIDC client secret read from its Secret.
+func (c *external) jwtConfig(ctx context.Context, cr *v1alpha1.JWTAuthConfig) (clients.JWTConfig, error) {
+	params := cr.Spec.ForProvider
+
+	config := clients.JWTConfig{
+		OIDCDiscoveryURL:     params.OIDCDiscoveryURL,
+		OIDCDiscoveryCAPEM:   params.OIDCDiscoveryCAPEM,
+		OIDCClientID:         params.OIDCClientID,
+		JWKSURL:              params.JWKSURL,
+		JWKSCAPEM:            params.JWKSCAPEM,
+		JWTValidationPubkeys: params.JWTValidationPubkeys,
+		BoundIssuer:          params.BoundIssuer,
+		JWTSupportedAlgs:     params.JWTSupportedAlgs,
+		DefaultRole:          params.DefaultRole,
+	}
+
+	if ref := params.OIDCClientSecretSecretRef; ref != nil {
+		secret, err := common.SecretValue(ctx, c.kube, *ref)
+		if err != nil {
+			return clients.JWTConfig{}, errors.Wrap(err, errGetClientSecret)
+		}
+
+		config.OIDCClientSecret = strings.TrimSpace(secret)
+	}
+
+	return config, nil
+}
+
+// secretsHash returns the checksum of the OIDC client secret, so that its
+// rotation is noticed although Vault never returns it.
+func secretsHash(config clients.JWTConfig) string {
+	if config.OIDCClientSecret == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(config.OIDCClientSecret)))
+}
+
+// samePEMs reports whether two lists hold the same PEM blocks, regardless of
+// their order and surrounding whitespace.
+func samePEMs(a, b []string) bool {
+	trim := func(pems []string) []string {
+		trimmed := make([]string, 0, len(pems))
+		for _, pem := range pems {
+			trimmed = append(trimmed, strings.TrimSpace(pem))
+		}
+		return trimmed
+	}
+
+	return common.SameSet(trim(a), trim(b))
+}
+
+// configDrift compares the configuration Vault holds with the declared one.
+func configDrift(cr *v1alpha1.JWTAuthConfig, desired clients.JWTConfig, current *clients.JWTConfig) []string {
+	drifts := make([]string, 0)
+
+	if desired.OIDCDiscoveryURL != current.OIDCDiscoveryURL {
+		drifts = append(drifts, "OIDC discovery URL differs")
+	}
+
+	if strings.TrimSpace(desired.OIDCDiscoveryCAPEM) != strings.TrimSpace(current.OIDCDiscoveryCAPEM) {
+		drifts = append(drifts, "OIDC discovery CA certificate differs")
+	}
+
+	if desired.OIDCClientID != current.OIDCClientID {
+		drifts = append(drifts, "OIDC client ID differs")
+	}
+
+	if desired.JWKSURL != current.JWKSURL {
+		drifts = append(drifts, "JWKS URL differs")
+	}
+
+	if strings.TrimSpace(desired.JWKSCAPEM) != strings.TrimSpace(current.JWKSCAPEM) {
+		drifts = append(drifts, "JWKS CA certificate differs")
+	}
+
+	if !samePEMs(desired.JWTValidationPubkeys, current.JWTValidationPubkeys) {
+		drifts = append(drifts, "JWT validation public keys differ")
+	}
+
+	if desired.BoundIssuer != current.BoundIssuer {
+		drifts = append(drifts, "bound issuer differs")
+	}
+
+	if len(desired.JWTSupportedAlgs) > 0 && !common.SameSet(desired.JWTSupportedAlgs, current.JWTSupportedAlgs) {
+		drifts = append(drifts, "JWT supported algorithms differ")
+	}
+
+	if desired.DefaultRole != current.DefaultRole {
+		drifts = append(drifts, "default role differs")
+	}
+
+	if secretsHash(desired) != cr.Status.AtProvider.SecretsHash {
+		drifts = append(drifts, "referenced Secrets changed")
+	}
+
+	return drifts
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.JWTAuthConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJWTAuthConfig)
+	}
+
+	// The configuration cannot be removed from Vault, so a deleted
+	// JWTAuthConfig no longer exists as far as the provider is concerned,
+	// whatever Vault holds and whether its Secret remains.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	current, err := c.service.GetJWTConfig(ctx, mount(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetConfig)
+	}
+
+	if current == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.Status.AtProvider.Path = fmt.Sprintf("auth/%s/config", mount(cr))
+
+	desired, err := c.jwtConfig(ctx, cr)
+
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if drifts := configDrift(cr, desired, current); len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// put writes the configuration to Vault and records the checksum of the OIDC
+// client secret it was read with.
+func (c *external) put(ctx context.Context, cr *v1alpha1.JWTAuthConfig) error {
+	config, err := c.jwtConfig(ctx, cr)
+
+	if err != nil {
+		return err
+	}
+
+	if err := c.service.PutJWTConfig(ctx, mount(cr), config); err != nil {
+		return errors.Wrap(err, errPutConfig)
+	}
+
+	cr.Status.AtProvider.SecretsHash = secretsHash(config)
+
+	return nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.JWTAuthConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJWTAuthConfig)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.JWTAuthConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotJWTAuthConfig)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete leaves the configuration in Vault: the jwt auth method has no way to
+// remove it, and it goes away along with the auth method. Observe then reports
+// the deleted JWTAuthConfig as gone.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if _, ok := mg.(*v1alpha1.JWTAuthConfig); !ok {
+		return errors.New(errNotJWTAuthConfig)
+	}
+
+	return nil
+}
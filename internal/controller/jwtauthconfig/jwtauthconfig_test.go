@@ -0,0 +1,205 @@
+package jwtauthconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+)
+
+const (
+	discoveryURL = "https://sso.example.com"
+	clientSecret = "s3cr3t"
+)
+
+func clientSecretFn(secret string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"client-secret": []byte(secret)}
+		return nil
+	}
+}
+
+func config(hash string) *v1alpha1.JWTAuthConfig {
+	return &v1alpha1.JWTAuthConfig{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "sso",
+		},
+		Spec: v1alpha1.JWTAuthConfigSpec{
+			ForProvider: v1alpha1.JWTAuthConfigParameters{
+				Mount:            "oidc",
+				OIDCDiscoveryURL: discoveryURL,
+				OIDCClientID:     "vault",
+				OIDCClientSecretSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "vault-oidc-client"},
+					Key:             "client-secret",
+				},
+				DefaultRole: "engineer",
+			},
+		},
+		Status: v1alpha1.JWTAuthConfigStatus{
+			AtProvider: v1alpha1.JWTAuthConfigObservation{SecretsHash: hash},
+		},
+	}
+}
+
+func TestJWTAuthConfig_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	applied := secretsHash(clients.JWTConfig{OIDCClientSecret: clientSecret})
+
+	current := &clients.JWTConfig{
+		OIDCDiscoveryURL: discoveryURL,
+		OIDCClientID:     "vault",
+		JWTSupportedAlgs: []string{"RS256"},
+		DefaultRole:      "engineer",
+	}
+
+	cases := map[string]struct {
+		reason      string
+		get         test.MockGetFn
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when the auth method is not configured": {
+			get: clientSecretFn(clientSecret),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetJWTConfig(gomock.Any(), "oidc").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date ignoring the algorithms left unset": {
+			get: clientSecretFn(clientSecret),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetJWTConfig(gomock.Any(), "oidc").Return(current, nil).Times(1)
+			},
+		},
+		"should report the settings that drifted": {
+			get: clientSecretFn("rotated"),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "bound issuer differs; referenced Secrets changed",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				drifted := *current
+				drifted.BoundIssuer = "https://other.example.com"
+				m.EXPECT().GetJWTConfig(gomock.Any(), "oidc").Return(&drifted, nil).Times(1)
+			},
+		},
+		"should fail when the client secret cannot be read": {
+			get: test.NewMockGetFn(errors.New("boom")),
+			want: want{
+				err: errors.Wrap(errors.Wrapf(errors.New("boom"), common.ErrGetSecretKey, "crossplane-system", "vault-oidc-client"), errGetClientSecret),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetJWTConfig(gomock.Any(), "oidc").Return(current, nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, kube: &test.MockClient{MockGet: testCase.get}}
+
+			got, err := e.Observe(context.Background(), config(applied))
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestJWTAuthConfig_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := clients.JWTConfig{
+		OIDCDiscoveryURL: discoveryURL,
+		OIDCClientID:     "vault",
+		OIDCClientSecret: clientSecret,
+		DefaultRole:      "engineer",
+	}
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutJWTConfig(gomock.Any(), "oidc", want).Return(nil).Times(1)
+
+	e := external{service: mock, kube: &test.MockClient{MockGet: clientSecretFn(clientSecret + "\n")}}
+	cr := config("")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(secretsHash(want), cr.Status.AtProvider.SecretsHash); diff != "" {
+		t.Errorf("e.Create(...): -want hash, +got hash:\n%s\n", diff)
+	}
+}
+
+// TestJWTAuthConfig_Delete walks a deleted JWTAuthConfig through Delete and
+// the Observe that follows, which must report it gone for its finalizer to be
+// removed, even once its Secret is deleted.
+func TestJWTAuthConfig_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	e := external{service: mock, kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New("not found"))}}
+
+	cr := config("")
+	now := v1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): unexpected error: %v", err)
+	}
+
+	got, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if got.ResourceExists {
+		t.Errorf("e.Observe(...): want a deleted JWTAuthConfig to no longer exist, got %+v", got)
+	}
+}
@@ -0,0 +1,219 @@
+package jwtauthrole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+func role(params v1alpha1.JWTAuthRoleParameters) *v1alpha1.JWTAuthRole {
+	return &v1alpha1.JWTAuthRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "deploy",
+		},
+		Spec: v1alpha1.JWTAuthRoleSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func TestJWTAuthRole_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		path        string
+		err         error
+	}
+
+	params := v1alpha1.JWTAuthRoleParameters{
+		RoleType:        "jwt",
+		UserClaim:       "repository",
+		BoundAudiences:  []string{"https://github.com/munditrade"},
+		BoundClaimsType: "glob",
+		BoundClaims: map[string][]string{
+			"repository": {"munditrade/*"},
+			"ref":        {"refs/heads/main", "refs/tags/*"},
+		},
+		TokenParameters: v1alpha1.TokenParameters{
+			TokenPolicies: []string{"deploy"},
+			TokenTTL:      &v1.Duration{Duration: 15 * time.Minute},
+		},
+	}
+
+	current := &clients.JWTRole{
+		RoleType:        "jwt",
+		UserClaim:       "repository",
+		BoundAudiences:  []string{"https://github.com/munditrade"},
+		BoundClaimsType: "glob",
+		BoundClaims: map[string][]string{
+			"repository": {"munditrade/*"},
+			"ref":        {"refs/tags/*", "refs/heads/main"},
+		},
+		ClaimMappings:       map[string]string{},
+		AllowedRedirectURIs: []string{},
+		OIDCScopes:          []string{},
+		Token: clients.TokenConfig{
+			Policies: []string{"deploy"},
+			TTL:      15 * time.Minute,
+			Type:     "default",
+		},
+	}
+
+	cases := map[string]struct {
+		reason      string
+		params      v1alpha1.JWTAuthRoleParameters
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when Vault has no such role": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetJWTRole(gomock.Any(), "jwt", "deploy").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date regardless of the order of claim values": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/jwt/role/deploy",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetJWTRole(gomock.Any(), "jwt", "deploy").Return(current, nil).Times(1)
+			},
+		},
+		"should report the settings that drifted": {
+			params: v1alpha1.JWTAuthRoleParameters{
+				Mount:           "github",
+				UserClaim:       "actor",
+				BoundAudiences:  []string{"https://github.com/munditrade"},
+				BoundClaimsType: "string",
+				BoundClaims: map[string][]string{
+					"repository": {"munditrade/*"},
+				},
+				TokenParameters: v1alpha1.TokenParameters{
+					TokenPolicies: []string{"deploy"},
+				},
+			},
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff: "bound claims differ; bound claims type differs; " +
+						"user claim differs",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/github/role/deploy",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetJWTRole(gomock.Any(), "github", "deploy").Return(current, nil).Times(1)
+			},
+		},
+		"should fail when the role cannot be read": {
+			params: params,
+			want: want{
+				err: errors.Wrap(errors.New("boom"), errGetRole),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetJWTRole(gomock.Any(), "jwt", "deploy").Return(nil, errors.New("boom")).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			cr := role(testCase.params)
+
+			got, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.path, cr.Status.AtProvider.Path); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want path, +got path:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestJWTAuthRole_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutJWTRole(gomock.Any(), "oidc", "engineer", clients.JWTRole{
+		RoleType:            "oidc",
+		UserClaim:           "email",
+		GroupsClaim:         "groups",
+		AllowedRedirectURIs: []string{"http://localhost:8250/oidc/callback"},
+		ClaimMappings:       map[string]string{"email": "email"},
+		Token: clients.TokenConfig{
+			Policies: []string{"engineer"},
+		},
+	}).Return(nil).Times(1)
+
+	e := external{service: mock}
+	cr := role(v1alpha1.JWTAuthRoleParameters{
+		Mount:               "oidc",
+		RoleType:            "oidc",
+		UserClaim:           "email",
+		GroupsClaim:         "groups",
+		AllowedRedirectURIs: []string{"http://localhost:8250/oidc/callback"},
+		ClaimMappings:       map[string]string{"email": "email"},
+		TokenParameters: v1alpha1.TokenParameters{
+			TokenPolicies: []string{"engineer"},
+		},
+	})
+	meta.SetExternalName(cr, "engineer")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+}
+
+func TestJWTAuthRole_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().DeleteJWTRole(gomock.Any(), "jwt", "deploy").Return(nil).Times(1)
+
+	e := external{service: mock}
+	cr := role(v1alpha1.JWTAuthRoleParameters{})
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("e.Delete(...): unexpected error: %v", err)
+	}
+}
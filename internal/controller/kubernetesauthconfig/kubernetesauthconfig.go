@@ -0,0 +1,348 @@
+package kubernetesauthconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotKubernetesAuthConfig = "managed resource is not a KubernetesAuthConfig custom resource"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errGetPC                   = "cannot get ProviderConfig"
+	errNoSecretRef             = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret               = "cannot get credentials Secret"
+	errGetCACert               = "cannot get kubernetes CA certificate"
+	errGetReviewerJWT          = "cannot get token reviewer JWT"
+	errGetConfig               = "cannot get kubernetes auth configuration"
+	errPutConfig               = "cannot write kubernetes auth configuration"
+
+	errNewClient = "cannot create new Service"
+
+	defaultMount = v1alpha1.AuthKubernetes
+)
+
+// Setup adds a controller that reconciles KubernetesAuthConfig managed
+// resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.KubernetesAuthConfigGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.KubernetesAuthConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-kubernetesauthconfig", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.KubernetesAuthConfig{}).
+			Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(secretConfigs(mgr.GetClient()))).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+// references reports whether ref selects the Secret.
+func references(ref *xpv1.SecretKeySelector, secret client.Object) bool {
+	return ref != nil && ref.Name == secret.GetName() && ref.Namespace == secret.GetNamespace()
+}
+
+// secretConfigs maps a Secret to the KubernetesAuthConfigs reading it, so
+// that rotating the CA certificate or the reviewer token re-applies them.
+func secretConfigs(kube client.Reader) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		configs := &v1alpha1.KubernetesAuthConfigList{}
+		if err := kube.List(context.TODO(), configs); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0)
+
+		for i := range configs.Items {
+			params := configs.Items[i].Spec.ForProvider
+
+			if references(params.KubernetesCACertSecretRef, obj) || references(params.TokenReviewerJWTSecretRef, obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: configs.Items[i].GetName()}})
+			}
+		}
+
+		return requests
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthConfig)
+	if !ok {
+		return nil, errors.New(errNotKubernetesAuthConfig)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc, kube: c.kube}, nil
+}
+
+type external struct {
+	service clients.AuthManager
+	kube    client.Reader
+}
+
+// mount returns the path the kubernetes auth method is enabled at.
+func mount(cr *v1alpha1.KubernetesAuthConfig) string {
+	if m := strings.Trim(cr.Spec.ForProvider.Mount, "/"); m != "" {
+		return m
+	}
+
+	return defaultMount
+}
+
+// kubernetesConfig returns the configuration declared by the
+// KubernetesAuthConfig, with the CA certificate and the reviewer token read
+// from their Secrets.
+func (c *external) kubernetesConfig(ctx context.Context, cr *v1alpha1.KubernetesAuthConfig) (clients.KubernetesConfig, error) {
+	params := cr.Spec.ForProvider
+
+	config := clients.KubernetesConfig{
+		Host:              params.KubernetesHost,
+		Issuer:            params.Issuer,
+		DisableLocalCAJWT: params.DisableLocalCAJWT,
+	}
+
+	if ref := params.KubernetesCACertSecretRef; ref != nil {
+		ca, err := common.SecretValue(ctx, c.kube, *ref)
+		if err != nil {
+			return clients.KubernetesConfig{}, errors.Wrap(err, errGetCACert)
+		}
+
+		config.CACert = ca
+	}
+
+	if ref := params.TokenReviewerJWTSecretRef; ref != nil {
+		jwt, err := common.SecretValue(ctx, c.kube, *ref)
+		if err != nil {
+			return clients.KubernetesConfig{}, errors.Wrap(err, errGetReviewerJWT)
+		}
+
+		config.TokenReviewerJWT = strings.TrimSpace(jwt)
+	}
+
+	return config, nil
+}
+
+// secretsHash returns the checksum of the values read from Secrets, so that
+// their rotation is noticed although Vault never returns the reviewer token.
+func secretsHash(config clients.KubernetesConfig) string {
+	if config.CACert == "" && config.TokenReviewerJWT == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(config.CACert + "\n" + config.TokenReviewerJWT))
+
+	return fmt.Sprintf("%x", sum)
+}
+
+// configDrift compares the configuration Vault holds with the declared one.
+func configDrift(cr *v1alpha1.KubernetesAuthConfig, desired clients.KubernetesConfig, current *clients.KubernetesConfig) []string {
+	drifts := make([]string, 0)
+
+	if desired.Host != current.Host {
+		drifts = append(drifts, "kubernetes host differs")
+	}
+
+	if desired.CACert != "" && strings.TrimSpace(desired.CACert) != strings.TrimSpace(current.CACert) {
+		drifts = append(drifts, "kubernetes CA certificate differs")
+	}
+
+	if desired.Issuer != "" && desired.Issuer != current.Issuer {
+		drifts = append(drifts, "issuer differs")
+	}
+
+	if desired.DisableLocalCAJWT != current.DisableLocalCAJWT {
+		drifts = append(drifts, "disable local CA JWT differs")
+	}
+
+	if secretsHash(desired) != cr.Status.AtProvider.SecretsHash {
+		drifts = append(drifts, "referenced Secrets changed")
+	}
+
+	return drifts
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKubernetesAuthConfig)
+	}
+
+	// The configuration cannot be removed from Vault, so a deleted
+	// KubernetesAuthConfig no longer exists as far as the provider is
+	// concerned, whatever Vault holds and whether its Secrets remain.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	current, err := c.service.GetKubernetesConfig(ctx, mount(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetConfig)
+	}
+
+	if current == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.Status.AtProvider.Path = fmt.Sprintf("auth/%s/config", mount(cr))
+
+	desired, err := c.kubernetesConfig(ctx, cr)
+
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if drifts := configDrift(cr, desired, current); len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// put writes the configuration to Vault and records the checksum of the
+// Secrets it was read from.
+func (c *external) put(ctx context.Context, cr *v1alpha1.KubernetesAuthConfig) error {
+	config, err := c.kubernetesConfig(ctx, cr)
+
+	if err != nil {
+		return err
+	}
+
+	if err := c.service.PutKubernetesConfig(ctx, mount(cr), config); err != nil {
+		return errors.Wrap(err, errPutConfig)
+	}
+
+	cr.Status.AtProvider.SecretsHash = secretsHash(config)
+
+	return nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKubernetesAuthConfig)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKubernetesAuthConfig)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete leaves the configuration in Vault: the kubernetes auth method has no
+// way to remove it, and it goes away along with the auth method. Observe then
+// reports the deleted KubernetesAuthConfig as gone.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if _, ok := mg.(*v1alpha1.KubernetesAuthConfig); !ok {
+		return errors.New(errNotKubernetesAuthConfig)
+	}
+
+	return nil
+}
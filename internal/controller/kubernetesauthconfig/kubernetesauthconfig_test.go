@@ -0,0 +1,230 @@
+package kubernetesauthconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+)
+
+const (
+	caCert = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	jwt    = "eyJhbGciOiJSUzI1NiJ9.reviewer"
+)
+
+func reviewerSecret(token string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{
+			"ca.crt": []byte(caCert),
+			"token":  []byte(token),
+		}
+		return nil
+	}
+}
+
+func config(hash string) *v1alpha1.KubernetesAuthConfig {
+	ref := func(key string) *xpv1.SecretKeySelector {
+		return &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "vault-token-reviewer"},
+			Key:             key,
+		}
+	}
+
+	return &v1alpha1.KubernetesAuthConfig{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "kubernetes",
+		},
+		Spec: v1alpha1.KubernetesAuthConfigSpec{
+			ForProvider: v1alpha1.KubernetesAuthConfigParameters{
+				KubernetesHost:            "https://kubernetes.default.svc:443",
+				KubernetesCACertSecretRef: ref("ca.crt"),
+				TokenReviewerJWTSecretRef: ref("token"),
+				DisableLocalCAJWT:         true,
+			},
+		},
+		Status: v1alpha1.KubernetesAuthConfigStatus{
+			AtProvider: v1alpha1.KubernetesAuthConfigObservation{SecretsHash: hash},
+		},
+	}
+}
+
+func TestKubernetesAuthConfig_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	applied := secretsHash(clients.KubernetesConfig{CACert: caCert, TokenReviewerJWT: jwt})
+
+	current := &clients.KubernetesConfig{
+		Host:              "https://kubernetes.default.svc:443",
+		CACert:            caCert,
+		DisableLocalCAJWT: true,
+	}
+
+	cases := map[string]struct {
+		reason      string
+		get         test.MockGetFn
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when the auth method is not configured": {
+			get: reviewerSecret(jwt),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesConfig(gomock.Any(), "kubernetes").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date while the Secrets are unchanged": {
+			get: reviewerSecret(jwt),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesConfig(gomock.Any(), "kubernetes").Return(current, nil).Times(1)
+			},
+		},
+		"should re-apply when the reviewer token rotates": {
+			get: reviewerSecret("eyJhbGciOiJSUzI1NiJ9.rotated"),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "referenced Secrets changed",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesConfig(gomock.Any(), "kubernetes").Return(current, nil).Times(1)
+			},
+		},
+		"should fail when a Secret cannot be read": {
+			get: test.NewMockGetFn(errors.New("boom")),
+			want: want{
+				err: errors.Wrap(errors.Wrapf(errors.New("boom"), common.ErrGetSecretKey, "crossplane-system", "vault-token-reviewer"), errGetCACert),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesConfig(gomock.Any(), "kubernetes").Return(current, nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, kube: &test.MockClient{MockGet: testCase.get}}
+
+			got, err := e.Observe(context.Background(), config(applied))
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesAuthConfig_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := clients.KubernetesConfig{
+		Host:              "https://kubernetes.default.svc:443",
+		CACert:            caCert,
+		TokenReviewerJWT:  jwt,
+		DisableLocalCAJWT: true,
+	}
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutKubernetesConfig(gomock.Any(), "kubernetes", want).Return(nil).Times(1)
+
+	e := external{service: mock, kube: &test.MockClient{MockGet: reviewerSecret(jwt + "\n")}}
+	cr := config("")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(secretsHash(want), cr.Status.AtProvider.SecretsHash); diff != "" {
+		t.Errorf("e.Create(...): -want hash, +got hash:\n%s\n", diff)
+	}
+}
+
+// TestKubernetesAuthConfig_Delete walks a deleted KubernetesAuthConfig
+// through Delete and the Observe that follows, which must report it gone for
+// its finalizer to be removed, even once its Secrets are deleted.
+func TestKubernetesAuthConfig_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	e := external{service: mock, kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New("not found"))}}
+
+	cr := config("")
+	now := v1.Now()
+	cr.SetDeletionTimestamp(&now)
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): unexpected error: %v", err)
+	}
+
+	got, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if got.ResourceExists {
+		t.Errorf("e.Observe(...): want a deleted KubernetesAuthConfig to no longer exist, got %+v", got)
+	}
+}
+
+func TestKubernetesAuthConfig_SecretConfigs(t *testing.T) {
+	other := config("")
+	other.SetName("other")
+	other.Spec.ForProvider.KubernetesCACertSecretRef = nil
+	other.Spec.ForProvider.TokenReviewerJWTSecretRef = nil
+
+	kube := &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1alpha1.KubernetesAuthConfigList).Items = []v1alpha1.KubernetesAuthConfig{*config(""), *other}
+		return nil
+	}}
+
+	secret := &corev1.Secret{ObjectMeta: v1.ObjectMeta{Namespace: "crossplane-system", Name: "vault-token-reviewer"}}
+	got := secretConfigs(kube)(secret)
+	want := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "kubernetes"}}}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("secretConfigs(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,249 @@
+package kubernetesauthrole
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotKubernetesAuthRole = "managed resource is not a KubernetesAuthRole custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errGetPC                 = "cannot get ProviderConfig"
+	errNoSecretRef           = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret             = "cannot get credentials Secret"
+	errGetRole               = "cannot get kubernetes auth role"
+	errPutRole               = "cannot write kubernetes auth role"
+	errDeleteRole            = "cannot delete kubernetes auth role"
+
+	errNewClient = "cannot create new Service"
+
+	defaultMount = v1alpha1.AuthKubernetes
+)
+
+// Setup adds a controller that reconciles KubernetesAuthRole managed
+// resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.KubernetesAuthRoleGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.KubernetesAuthRoleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-kubernetesauthrole", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.KubernetesAuthRole{}).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthRole)
+	if !ok {
+		return nil, errors.New(errNotKubernetesAuthRole)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+type external struct {
+	service clients.AuthManager
+}
+
+// roleName returns the name of the role in Vault: the external name of the
+// KubernetesAuthRole, falling back to its name.
+func roleName(cr *v1alpha1.KubernetesAuthRole) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+
+	return cr.GetName()
+}
+
+// mount returns the path the kubernetes auth method is enabled at.
+func mount(cr *v1alpha1.KubernetesAuthRole) string {
+	if m := strings.Trim(cr.Spec.ForProvider.Mount, "/"); m != "" {
+		return m
+	}
+
+	return defaultMount
+}
+
+// kubernetesRole returns the role declared by the KubernetesAuthRole.
+func kubernetesRole(params v1alpha1.KubernetesAuthRoleParameters) clients.KubernetesRole {
+	return clients.KubernetesRole{
+		BoundServiceAccountNames:      params.BoundServiceAccountNames,
+		BoundServiceAccountNamespaces: params.BoundServiceAccountNamespaces,
+		Audience:                      params.Audience,
+		AliasNameSource:               params.AliasNameSource,
+		Token:                         common.TokenConfig(params.TokenParameters),
+	}
+}
+
+// roleDrift compares the role Vault holds with the declared one.
+func roleDrift(params v1alpha1.KubernetesAuthRoleParameters, current *clients.KubernetesRole) []string {
+	drifts := make([]string, 0)
+
+	if !common.SameSet(params.BoundServiceAccountNames, current.BoundServiceAccountNames) {
+		drifts = append(drifts, "bound service account names differ")
+	}
+
+	if !common.SameSet(params.BoundServiceAccountNamespaces, current.BoundServiceAccountNamespaces) {
+		drifts = append(drifts, "bound service account namespaces differ")
+	}
+
+	if params.Audience != "" && params.Audience != current.Audience {
+		drifts = append(drifts, "audience differs")
+	}
+
+	if params.AliasNameSource != "" && params.AliasNameSource != current.AliasNameSource {
+		drifts = append(drifts, "alias name source differs")
+	}
+
+	return append(drifts, common.TokenDrift(params.TokenParameters, &current.Token)...)
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKubernetesAuthRole)
+	}
+
+	role, err := c.service.GetKubernetesRole(ctx, mount(cr), roleName(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRole)
+	}
+
+	if role == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.Status.AtProvider.Path = fmt.Sprintf("auth/%s/role/%s", mount(cr), roleName(cr))
+	cr.Status.AtProvider.TokenPolicies = role.Token.Policies
+
+	if drifts := roleDrift(cr.Spec.ForProvider, role); len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKubernetesAuthRole)
+	}
+
+	if err := c.service.PutKubernetesRole(ctx, mount(cr), roleName(cr), kubernetesRole(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPutRole)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKubernetesAuthRole)
+	}
+
+	if err := c.service.PutKubernetesRole(ctx, mount(cr), roleName(cr), kubernetesRole(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPutRole)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.KubernetesAuthRole)
+	if !ok {
+		return errors.New(errNotKubernetesAuthRole)
+	}
+
+	return errors.Wrap(c.service.DeleteKubernetesRole(ctx, mount(cr), roleName(cr)), errDeleteRole)
+}
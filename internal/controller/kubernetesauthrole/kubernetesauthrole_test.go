@@ -0,0 +1,201 @@
+package kubernetesauthrole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+func role(params v1alpha1.KubernetesAuthRoleParameters) *v1alpha1.KubernetesAuthRole {
+	return &v1alpha1.KubernetesAuthRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "billing-api",
+		},
+		Spec: v1alpha1.KubernetesAuthRoleSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func TestKubernetesAuthRole_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		path        string
+		err         error
+	}
+
+	params := v1alpha1.KubernetesAuthRoleParameters{
+		BoundServiceAccountNames:      []string{"billing-api"},
+		BoundServiceAccountNamespaces: []string{"billing"},
+		Audience:                      "vault",
+		TokenParameters: v1alpha1.TokenParameters{
+			TokenPolicies: []string{"billing-read", "default-read"},
+			TokenTTL:      &v1.Duration{Duration: time.Hour},
+		},
+	}
+
+	current := &clients.KubernetesRole{
+		BoundServiceAccountNames:      []string{"billing-api"},
+		BoundServiceAccountNamespaces: []string{"billing"},
+		Audience:                      "vault",
+		AliasNameSource:               "serviceaccount_uid",
+		Token: clients.TokenConfig{
+			Policies: []string{"default-read", "billing-read"},
+			TTL:      time.Hour,
+			Type:     "default",
+		},
+	}
+
+	cases := map[string]struct {
+		reason      string
+		params      v1alpha1.KubernetesAuthRoleParameters
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when Vault has no such role": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesRole(gomock.Any(), "kubernetes", "billing-api").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date ignoring the settings left unset": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/kubernetes/role/billing-api",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesRole(gomock.Any(), "kubernetes", "billing-api").Return(current, nil).Times(1)
+			},
+		},
+		"should report the settings that drifted": {
+			params: v1alpha1.KubernetesAuthRoleParameters{
+				Mount:                         "clusters/prod",
+				BoundServiceAccountNames:      []string{"billing-api", "billing-worker"},
+				BoundServiceAccountNamespaces: []string{"billing"},
+				TokenParameters: v1alpha1.TokenParameters{
+					TokenPolicies: []string{"billing-write"},
+					TokenTTL:      &v1.Duration{Duration: 30 * time.Minute},
+				},
+			},
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff: "bound service account names differ; token policies differ; " +
+						"token TTL differs",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/clusters/prod/role/billing-api",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesRole(gomock.Any(), "clusters/prod", "billing-api").Return(current, nil).Times(1)
+			},
+		},
+		"should fail when the role cannot be read": {
+			params: params,
+			want: want{
+				err: errors.Wrap(errors.New("boom"), errGetRole),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetKubernetesRole(gomock.Any(), "kubernetes", "billing-api").Return(nil, errors.New("boom")).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			cr := role(testCase.params)
+
+			got, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.path, cr.Status.AtProvider.Path); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want path, +got path:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesAuthRole_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutKubernetesRole(gomock.Any(), "kubernetes", "billing", clients.KubernetesRole{
+		BoundServiceAccountNames:      []string{"billing-api"},
+		BoundServiceAccountNamespaces: []string{"billing"},
+		Token: clients.TokenConfig{
+			Policies:        []string{"billing-read"},
+			MaxTTL:          4 * time.Hour,
+			NoDefaultPolicy: true,
+		},
+	}).Return(nil).Times(1)
+
+	e := external{service: mock}
+	cr := role(v1alpha1.KubernetesAuthRoleParameters{
+		BoundServiceAccountNames:      []string{"billing-api"},
+		BoundServiceAccountNamespaces: []string{"billing"},
+		TokenParameters: v1alpha1.TokenParameters{
+			TokenPolicies:        []string{"billing-read"},
+			TokenMaxTTL:          &v1.Duration{Duration: 4 * time.Hour},
+			TokenNoDefaultPolicy: true,
+		},
+	})
+	meta.SetExternalName(cr, "billing")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+}
+
+func TestKubernetesAuthRole_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().DeleteKubernetesRole(gomock.Any(), "clusters/prod", "billing-api").Return(nil).Times(1)
+
+	e := external{service: mock}
+	cr := role(v1alpha1.KubernetesAuthRoleParameters{Mount: "/clusters/prod/"})
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("e.Delete(...): unexpected error: %v", err)
+	}
+}
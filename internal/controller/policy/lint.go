@@ -0,0 +1,199 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/clients/vault"
+	"github.com/munditrade/provider-secret/internal/common"
+)
+
+const (
+	// TypeLinted is the condition reporting the outcome of the Policy linter.
+	TypeLinted xpv1.ConditionType = "Linted"
+
+	reasonLintClean    xpv1.ConditionReason = "NoFindings"
+	reasonLintFindings xpv1.ConditionReason = "FindingsDetected"
+	reasonLintRejected xpv1.ConditionReason = "FindingsRejected"
+
+	reasonLintFinding event.Reason = "PolicyLintFinding"
+
+	errLintRejected = "policy rejected by lint"
+
+	sudoCapability = "sudo"
+	sysPath        = "sys/"
+)
+
+var knownCapabilities = []string{
+	"create", "read", "update", "patch", "delete", "list", sudoCapability, denyCapability,
+}
+
+// A lintFinding is a problem found in the rules of a Policy.
+type lintFinding struct {
+	kind    v1alpha12.PolicyLintFinding
+	message string
+}
+
+// coversSys reports whether a glob path grants access to the whole sys/
+// backend, like "*" or "sys/*".
+func coversSys(path string) bool {
+	if !strings.HasSuffix(path, "*") {
+		return false
+	}
+
+	return strings.HasPrefix(sysPath, strings.TrimSuffix(path, "*"))
+}
+
+// lintPolicies analyses the rules of a Policy as they are declared, before
+// Vault normalizes them.
+func lintPolicies(policies []clients.Policy) []lintFinding {
+	findings := make([]lintFinding, 0)
+	declared := make(map[string][]string)
+
+	for _, policy := range policies {
+		path := normalizePath(policy.PathConfig.Path)
+		capabilities := policy.PathConfig.Capabilities
+
+		for _, capability := range capabilities {
+			if !contains(knownCapabilities, capability) {
+				findings = append(findings, lintFinding{
+					kind:    v1alpha12.PolicyLintUnknownCapability,
+					message: fmt.Sprintf("rule %q uses unknown capability %q", path, capability),
+				})
+			}
+		}
+
+		if contains(capabilities, denyCapability) && len(capabilities) > 1 {
+			findings = append(findings, lintFinding{
+				kind:    v1alpha12.PolicyLintDenyWithOthers,
+				message: fmt.Sprintf("rule %q combines deny with other capabilities, which Vault ignores", path),
+			})
+		}
+
+		if !contains(capabilities, denyCapability) {
+			if contains(capabilities, sudoCapability) {
+				findings = append(findings, lintFinding{
+					kind:    v1alpha12.PolicyLintSudoGrant,
+					message: fmt.Sprintf("rule %q grants sudo", path),
+				})
+			}
+
+			if coversSys(path) {
+				findings = append(findings, lintFinding{
+					kind:    v1alpha12.PolicyLintSysWildcard,
+					message: fmt.Sprintf("rule %q grants access to the whole sys/ backend", path),
+				})
+			}
+		}
+
+		if previous, exist := declared[path]; exist && !common.SameSet(distinct(previous), distinct(capabilities)) {
+			findings = append(findings, lintFinding{
+				kind:    v1alpha12.PolicyLintConflictingDuplicate,
+				message: fmt.Sprintf("rule %q is declared more than once with different capabilities", path),
+			})
+		}
+
+		declared[path] = capabilities
+	}
+
+	return findings
+}
+
+// lintCondition summarizes the findings of the linter, flagging whether any
+// of them is rejected.
+func lintCondition(findings []lintFinding, rejected bool) xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               TypeLinted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonLintClean,
+	}
+
+	if len(findings) == 0 {
+		return c
+	}
+
+	messages := make([]string, 0, len(findings))
+	for _, f := range findings {
+		messages = append(messages, fmt.Sprintf("%s: %s", f.kind, f.message))
+	}
+
+	c.Status = corev1.ConditionFalse
+	c.Reason = reasonLintFindings
+	c.Message = strings.Join(messages, "; ")
+
+	if rejected {
+		c.Reason = reasonLintRejected
+	}
+
+	return c
+}
+
+// declaredPolicies returns the rules of the Policy as they are written, before
+// Vault validates and normalizes them, so that the linter sees unknown
+// capabilities and deny along with other capabilities.
+func declaredPolicies(cr *v1alpha1.Policy) ([]clients.Policy, error) {
+	if cr.Spec.ForProvider.Document != "" {
+		policies, err := vault.ParseRawDocument(cr.Spec.ForProvider.Document)
+
+		return policies, errors.Wrap(err, errParseDocument)
+	}
+
+	policies := make([]clients.Policy, 0, len(cr.Spec.ForProvider.Rules))
+	for _, rule := range cr.Spec.ForProvider.Rules {
+		policies = append(policies, clients.Policy{
+			PathConfig: clients.PathConfig{
+				Path:         rule.Path,
+				Capabilities: rule.Capabilities,
+			},
+		})
+	}
+
+	return policies, nil
+}
+
+// lintRejections returns the findings the ProviderConfig turns into hard
+// rejections.
+func lintRejections(pc *v1alpha12.ProviderConfig) []v1alpha12.PolicyLintFinding {
+	if pc.Spec.PolicyLint == nil {
+		return nil
+	}
+
+	return pc.Spec.PolicyLint.Reject
+}
+
+// lint reports the findings of the linter as events and as the Linted
+// condition, and fails when any of them is rejected.
+func lint(recorder event.Recorder, rejections []v1alpha12.PolicyLintFinding, mg resource.Managed, policies []clients.Policy) error {
+	findings := lintPolicies(policies)
+	rejected := make([]string, 0)
+
+	for _, f := range findings {
+		recorder.Event(mg, event.Warning(reasonLintFinding, errors.New(f.message)))
+
+		for _, kind := range rejections {
+			if kind == f.kind {
+				rejected = append(rejected, f.message)
+				break
+			}
+		}
+	}
+
+	mg.SetConditions(lintCondition(findings, len(rejected) > 0))
+
+	if len(rejected) > 0 {
+		return errors.Errorf("%s: %s", errLintRejected, strings.Join(rejected, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/clients/vault"
+)
+
+func TestPolicy_LintPolicies(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		rules  []clients.Policy
+		want   []v1alpha12.PolicyLintFinding
+	}{
+		"should not report plain rules": {
+			rules: []clients.Policy{
+				{PathConfig: clients.PathConfig{Path: "kv/data/team-a/*", Capabilities: []string{"read", "list"}}},
+				{PathConfig: clients.PathConfig{Path: "sys/policies/acl/team-a", Capabilities: []string{"read"}}},
+			},
+			want: []v1alpha12.PolicyLintFinding{},
+		},
+		"should report sudo grants and wildcards on sys": {
+			rules: []clients.Policy{
+				{PathConfig: clients.PathConfig{Path: "sys/mounts", Capabilities: []string{"read", "sudo"}}},
+				{PathConfig: clients.PathConfig{Path: "sys/*", Capabilities: []string{"read"}}},
+				{PathConfig: clients.PathConfig{Path: "*", Capabilities: []string{"deny"}}},
+			},
+			want: []v1alpha12.PolicyLintFinding{
+				v1alpha12.PolicyLintSudoGrant,
+				v1alpha12.PolicyLintSysWildcard,
+			},
+		},
+		"should report deny with others, unknown capabilities and conflicting duplicates": {
+			rules: []clients.Policy{
+				{PathConfig: clients.PathConfig{Path: "kv/data/a", Capabilities: []string{"deny", "read"}}},
+				{PathConfig: clients.PathConfig{Path: "kv/data/b", Capabilities: []string{"write"}}},
+				{PathConfig: clients.PathConfig{Path: "kv/data/c", Capabilities: []string{"read"}}},
+				{PathConfig: clients.PathConfig{Path: "/kv/data/c", Capabilities: []string{"update"}}},
+			},
+			want: []v1alpha12.PolicyLintFinding{
+				v1alpha12.PolicyLintDenyWithOthers,
+				v1alpha12.PolicyLintUnknownCapability,
+				v1alpha12.PolicyLintConflictingDuplicate,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			got := make([]v1alpha12.PolicyLintFinding, 0)
+			for _, f := range lintPolicies(testCase.rules) {
+				got = append(got, f.kind)
+			}
+
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("\n%s\nlintPolicies(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPolicy_LintDocument(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		document string
+		want     []v1alpha12.PolicyLintFinding
+	}{
+		"should report deny with others although Vault keeps deny only": {
+			document: `path "kv/data/a" { capabilities = ["deny", "read"] }`,
+			want:     []v1alpha12.PolicyLintFinding{v1alpha12.PolicyLintDenyWithOthers},
+		},
+		"should report unknown capabilities although Vault cannot parse them": {
+			document: `path "kv/data/b" { capabilities = ["write"] }`,
+			want:     []v1alpha12.PolicyLintFinding{v1alpha12.PolicyLintUnknownCapability},
+		},
+		"should report the capabilities granted by the legacy policy attribute": {
+			document: `path "sys/*" { policy = "sudo" }`,
+			want: []v1alpha12.PolicyLintFinding{
+				v1alpha12.PolicyLintSudoGrant,
+				v1alpha12.PolicyLintSysWildcard,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Policy{
+				Spec: v1alpha1.PolicySpec{
+					ForProvider: v1alpha1.PolicyParameters{
+						Document: testCase.document,
+					},
+				},
+			}
+
+			declared, err := declaredPolicies(cr)
+			if err != nil {
+				t.Fatalf("\n%s\ndeclaredPolicies(...): unexpected error: %v", testCase.reason, err)
+			}
+
+			got := make([]v1alpha12.PolicyLintFinding, 0)
+			for _, f := range lintPolicies(declared) {
+				got = append(got, f.kind)
+			}
+
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("\n%s\nlintPolicies(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPolicy_LintOnCreate(t *testing.T) {
+	type prepareMock func(m *clients.MockPolicyManager)
+
+	type want struct {
+		err    error
+		status corev1.ConditionStatus
+	}
+
+	rules := []v1alpha1.Rule{
+		{
+			Path:         "sys/*",
+			Capabilities: []string{"read", "sudo"},
+		},
+	}
+
+	cases := map[string]struct {
+		reason      string
+		document    string
+		rejections  []v1alpha12.PolicyLintFinding
+		want        want
+		prepareMock prepareMock
+	}{
+		"should write the policy when findings are only reported": {
+			want: want{
+				err:    nil,
+				status: corev1.ConditionFalse,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Put(gomock.Any(), "the-super-policy", gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		"should not write the policy when a finding is rejected": {
+			rejections: []v1alpha12.PolicyLintFinding{v1alpha12.PolicyLintSudoGrant},
+			want: want{
+				err:    errors.Errorf("%s: %s", errLintRejected, `rule "sys/*" grants sudo`),
+				status: corev1.ConditionFalse,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {},
+		},
+		"should reject a document using an unknown capability": {
+			document:   `path "kv/data/b" { capabilities = ["write"] }`,
+			rejections: []v1alpha12.PolicyLintFinding{v1alpha12.PolicyLintUnknownCapability},
+			want: want{
+				err:    errors.Errorf("%s: %s", errLintRejected, `rule "kv/data/b" uses unknown capability "write"`),
+				status: corev1.ConditionFalse,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockPolicyManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, recorder: event.NewNopRecorder(), lintRejections: testCase.rejections}
+			params := v1alpha1.PolicyParameters{Rules: rules}
+			if testCase.document != "" {
+				params = v1alpha1.PolicyParameters{Document: testCase.document}
+			}
+			cr := &v1alpha1.Policy{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "the-super-policy",
+				},
+				Spec: v1alpha1.PolicySpec{
+					ForProvider: params,
+				},
+			}
+
+			_, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.status, cr.GetCondition(TypeLinted).Status); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPolicy_LintOnObserve(t *testing.T) {
+	type want struct {
+		err    error
+		status corev1.ConditionStatus
+	}
+
+	document := `path "kv/data/b" { capabilities = ["write"] }`
+	_, parseErr := vault.ParseDocument(document)
+
+	cases := map[string]struct {
+		reason     string
+		rejections []v1alpha12.PolicyLintFinding
+		want       want
+	}{
+		"should report the findings of a document Vault cannot parse": {
+			want: want{
+				err:    errors.Wrap(parseErr, errParseDocument),
+				status: corev1.ConditionFalse,
+			},
+		},
+		"should reject a document using an unknown capability": {
+			rejections: []v1alpha12.PolicyLintFinding{v1alpha12.PolicyLintUnknownCapability},
+			want: want{
+				err:    errors.Errorf("%s: %s", errLintRejected, `rule "kv/data/b" uses unknown capability "write"`),
+				status: corev1.ConditionFalse,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockPolicyManager(ctrl)
+			mock.EXPECT().Get(gomock.Any(), "the-super-policy").Return([]clients.Policy{}, nil).Times(1)
+			e := external{service: mock, recorder: event.NewNopRecorder(), lintRejections: testCase.rejections}
+			cr := &v1alpha1.Policy{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "the-super-policy",
+				},
+				Spec: v1alpha1.PolicySpec{
+					ForProvider: v1alpha1.PolicyParameters{Document: document},
+				},
+			}
+
+			_, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.status, cr.GetCondition(TypeLinted).Status); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
@@ -2,20 +2,27 @@ package policy
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
 	"github.com/munditrade/provider-secret/internal/clients"
 	"github.com/munditrade/provider-secret/internal/clients/exceptions"
+	"github.com/munditrade/provider-secret/internal/clients/vault"
+	"github.com/munditrade/provider-secret/internal/common"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sort"
 	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -32,6 +39,13 @@ const (
 	errGetSecret    = "cannot get credentials Secret"
 
 	errNewClient = "cannot create new Service"
+
+	errRulesAndDocument = "policy must set either rules or document, not both"
+	errParseDocument    = "cannot parse policy document"
+
+	denyCapability = "deny"
+
+	reasonDrift event.Reason = "DriftDetected"
 )
 
 func contains(s []string, e string) bool {
@@ -43,40 +57,171 @@ func contains(s []string, e string) bool {
 	return false
 }
 
-func policyChange(old clients.Policy, current clients.Policy) bool {
-	currentCapabilities := current.PathConfig.Capabilities
-	oldCapabilities := old.PathConfig.Capabilities
+// distinct returns the elements of a list without their repetitions, which
+// mean nothing to Vault in capabilities and parameter constraints.
+func distinct(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
 
-	oldPath := strings.Split(old.PathConfig.Path, "*")[0]
-	newPath := strings.Split(current.PathConfig.Path, "*")[0]
+	return unique
+}
 
-	if oldPath != newPath {
-		return true
+// sameParameters compares parameter constraints the way Vault stores them,
+// with case-insensitive names and order-insensitive values.
+func sameParameters(old map[string][]string, current map[string][]string) bool {
+	if len(old) != len(current) {
+		return false
 	}
 
-	if len(oldCapabilities) != len(currentCapabilities) {
-		return true
+	currentLower := make(map[string][]string, len(current))
+	for name, values := range current {
+		currentLower[strings.ToLower(name)] = values
 	}
 
-	for _, cap := range oldCapabilities {
-		if !contains(currentCapabilities, cap) {
-			return true
+	for name, values := range old {
+		currentValues, exist := currentLower[strings.ToLower(name)]
+		if !exist || !common.SameSet(distinct(values), distinct(currentValues)) {
+			return false
 		}
 	}
 
-	return false
+	return true
+}
+
+// normalizePath returns the path pattern as Vault keeps it. Vault drops a
+// leading slash but keeps the glob and segment wildcards meaningful, so
+// "foo/*", "foo/" and "foo*" remain different rules.
+func normalizePath(path string) string {
+	return strings.TrimPrefix(path, "/")
+}
+
+// ruleDrift describes how a rule stored in Vault differs from the desired
+// one, or returns an empty string when both are equal.
+func ruleDrift(old clients.PathConfig, current clients.PathConfig) string {
+	switch {
+	case !common.SameSet(distinct(old.Capabilities), distinct(current.Capabilities)):
+		return "capabilities differ"
+	case !sameParameters(old.AllowedParameters, current.AllowedParameters):
+		return "allowed parameters differ"
+	case !sameParameters(old.DeniedParameters, current.DeniedParameters):
+		return "denied parameters differ"
+	case !common.SameSet(distinct(old.RequiredParameters), distinct(current.RequiredParameters)):
+		return "required parameters differ"
+	case old.MinWrappingTTL != current.MinWrappingTTL:
+		return "min wrapping TTL differs"
+	case old.MaxWrappingTTL != current.MaxWrappingTTL:
+		return "max wrapping TTL differs"
+	}
+
+	return ""
+}
+
+// policyDrift compares the rules stored in Vault with the desired ones, keyed
+// by their exact path pattern, and describes every rule that is out of sync.
+func policyDrift(old []clients.Policy, current []clients.Policy) []string {
+	oldPoliciesAsMap := make(map[string]clients.Policy)
+	currentPolicyAsMap := make(map[string]clients.Policy)
+
+	for _, policy := range old {
+		oldPoliciesAsMap[normalizePath(policy.PathConfig.Path)] = policy
+	}
+
+	for _, policy := range current {
+		currentPolicyAsMap[normalizePath(policy.PathConfig.Path)] = policy
+	}
+
+	drifts := make([]string, 0)
+
+	for path, currentPolicy := range currentPolicyAsMap {
+		oldPolicy, exist := oldPoliciesAsMap[path]
+		if !exist {
+			drifts = append(drifts, fmt.Sprintf("rule %q is missing in Vault", path))
+			continue
+		}
+
+		if drift := ruleDrift(oldPolicy.PathConfig, currentPolicy.PathConfig); drift != "" {
+			drifts = append(drifts, fmt.Sprintf("rule %q: %s", path, drift))
+		}
+	}
+
+	for path := range oldPoliciesAsMap {
+		if _, exist := currentPolicyAsMap[path]; !exist {
+			drifts = append(drifts, fmt.Sprintf("rule %q is not part of the policy", path))
+		}
+	}
+
+	sort.Strings(drifts)
+
+	return drifts
 }
 
 func getPolicy(rule v1alpha1.Rule) clients.Policy {
-	path := rule.Path
-	capabilities := rule.Capabilities
+	// Vault ignores every other setting of a path that denies access, so the
+	// rule is reduced the same way to keep it comparable with what is stored.
+	if contains(rule.Capabilities, denyCapability) {
+		return clients.Policy{
+			PathConfig: clients.PathConfig{
+				Path:         rule.Path,
+				Capabilities: []string{denyCapability},
+			},
+		}
+	}
+
+	config := clients.PathConfig{
+		Path:               rule.Path,
+		Capabilities:       rule.Capabilities,
+		AllowedParameters:  rule.AllowedParameters,
+		DeniedParameters:   rule.DeniedParameters,
+		RequiredParameters: rule.RequiredParameters,
+	}
 
-	return clients.Policy{
-		PathConfig: clients.PathConfig{
-			Path:         path,
-			Capabilities: capabilities,
-		},
+	if rule.MinWrappingTTL != nil {
+		config.MinWrappingTTL = rule.MinWrappingTTL.Duration
 	}
+
+	if rule.MaxWrappingTTL != nil {
+		config.MaxWrappingTTL = rule.MaxWrappingTTL.Duration
+	}
+
+	return clients.Policy{PathConfig: config}
+}
+
+func getPolicies(rules []v1alpha1.Rule) []clients.Policy {
+	policies := make([]clients.Policy, 0, len(rules))
+
+	for _, rule := range rules {
+		policies = append(policies, getPolicy(rule))
+	}
+
+	return policies
+}
+
+// desiredPolicies returns the rules a Policy expects to find in Vault, parsing
+// its document when it is written as raw HCL.
+func desiredPolicies(cr *v1alpha1.Policy) ([]clients.Policy, error) {
+	params := cr.Spec.ForProvider
+
+	if params.Document == "" {
+		return getPolicies(params.Rules), nil
+	}
+
+	if len(params.Rules) > 0 {
+		return nil, errors.New(errRulesAndDocument)
+	}
+
+	policies, err := vault.ParseDocument(params.Document)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseDocument)
+	}
+
+	return policies, nil
 }
 
 func Setup(newPolicyManager clients.GetPolicyManager) func(mgr ctrl.Manager, o controller.Options) error {
@@ -88,14 +233,17 @@ func Setup(newPolicyManager clients.GetPolicyManager) func(mgr ctrl.Manager, o c
 			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
 		}
 
+		recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 		r := managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.PolicyGroupVersionKind),
 			managed.WithExternalConnecter(&connector{
 				kube:         mgr.GetClient(),
 				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				recorder:     recorder,
 				newServiceFn: newPolicyManager}),
 			managed.WithLogger(o.Logger.WithValues("controller-policy", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithRecorder(recorder),
 			managed.WithConnectionPublishers(cps...))
 
 		return ctrl.NewControllerManagedBy(mgr).
@@ -110,46 +258,124 @@ func Setup(newPolicyManager clients.GetPolicyManager) func(mgr ctrl.Manager, o c
 type connector struct {
 	kube         client.Client
 	usage        resource.Tracker
+	recorder     event.Recorder
 	newServiceFn clients.GetPolicyManager
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*v1alpha1.Policy)
-	if !ok {
+	if _, ok := mg.(*v1alpha1.Policy); !ok {
 		return nil, errors.New(errNotPolicy)
 	}
 
+	svc, pc, err := c.connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{service: svc, recorder: c.recorder, lintRejections: lintRejections(pc)}, nil
+}
+
+// connect builds the Vault client from the credentials of the ProviderConfig
+// the resource references.
+func (c *connector) connect(ctx context.Context, mg resource.Managed) (clients.PolicyManager, *v1alpha12.ProviderConfig, error) {
 	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
+		return nil, nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
 	pc := &v1alpha12.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, nil, errors.Wrap(err, errGetPC)
 	}
 
 	ref := pc.Spec.Credentials.ConnectionSecretRef
 	if ref == nil {
-		return nil, errors.New(errNoSecretRef)
+		return nil, nil, errors.New(errNoSecretRef)
 	}
 
 	s := &corev1.Secret{}
 
 	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
-		return nil, errors.Wrap(err, errGetSecret)
+		return nil, nil, errors.Wrap(err, errGetSecret)
 	}
 
 	svc, err := c.newServiceFn(s.Data)
 
 	if err != nil {
-		return nil, errors.Wrap(err, errNewClient)
+		return nil, nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{service: svc}, nil
+	return svc, pc, nil
 }
 
 type external struct {
-	service clients.PolicyManager
+	service        clients.PolicyManager
+	recorder       event.Recorder
+	lintRejections []v1alpha12.PolicyLintFinding
+}
+
+// policyName returns the name of the policy in Vault, which is the external
+// name of the resource and defaults to its metadata name.
+func policyName(mg resource.Managed) string {
+	if name := meta.GetExternalName(mg); name != "" {
+		return name
+	}
+
+	return mg.GetName()
+}
+
+// hash returns the SHA-256 checksum of a document, hex encoded.
+func hash(doc string) string {
+	sum := sha256.Sum256([]byte(doc))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// setApplied records the rules Vault holds for the Policy in its status, as
+// an ACL document.
+func setApplied(cr *v1alpha1.Policy, policies []clients.Policy) {
+	doc := vault.RenderPolicy(policies)
+
+	cr.Status.AtProvider.Document = doc
+	cr.Status.AtProvider.Hash = hash(doc)
+}
+
+// put writes the Policy to Vault, as its raw document when it has one.
+func (c *external) put(ctx context.Context, cr *v1alpha1.Policy) error {
+	declared, err := declaredPolicies(cr)
+	if err != nil {
+		return err
+	}
+
+	if err := lint(c.recorder, c.lintRejections, cr, declared); err != nil {
+		return err
+	}
+
+	policies, err := desiredPolicies(cr)
+	if err != nil {
+		return err
+	}
+
+	if cr.Spec.ForProvider.Document != "" {
+		return c.service.PutDocument(ctx, policyName(cr), cr.Spec.ForProvider.Document)
+	}
+
+	return c.service.Put(ctx, policyName(cr), policies)
+}
+
+// lintInvalid lints the rules of a Policy Vault cannot parse, so that their
+// findings are still reported, and rejected when the ProviderConfig says so.
+// It returns the rejection if there is one, and the parse error otherwise.
+func (c *external) lintInvalid(cr *v1alpha1.Policy, err error) error {
+	declared, derr := declaredPolicies(cr)
+	if derr != nil {
+		return err
+	}
+
+	if lerr := lint(c.recorder, c.lintRejections, cr, declared); lerr != nil {
+		return lerr
+	}
+
+	return err
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -160,8 +386,8 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotPolicy)
 	}
 
-	policyName := cr.ObjectMeta.Name
-	oldPolicies, err := c.service.Get(ctx, policyName)
+	name := policyName(cr)
+	oldPolicies, err := c.service.Get(ctx, name)
 
 	if errors.As(err, &policyNotFoundErr) {
 		return managed.ExternalObservation{ResourceExists: false}, nil
@@ -171,45 +397,38 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, "error getting policy")
 	}
 
-	oldPoliciesAsMap := make(map[string]clients.Policy)
-	currentPolicyAsMap := make(map[string]clients.Policy)
+	cr.Status.AtProvider.Name = name
+	setApplied(cr, oldPolicies)
 
-	for _, rule := range cr.Spec.ForProvider.Rules {
-		path := strings.Split(rule.Path, "*")[0]
-		currentPolicyAsMap[path] = getPolicy(rule)
+	// A deleted Policy is removed whatever its rules, even ones Vault rejects.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
 	}
 
-	for _, policy := range oldPolicies {
-		path := strings.Split(policy.PathConfig.Path, "*")[0]
-		oldPoliciesAsMap[path] = policy
+	desired, err := desiredPolicies(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, c.lintInvalid(cr, err)
 	}
 
-	if len(oldPoliciesAsMap) != len(currentPolicyAsMap) {
+	drifts := policyDrift(oldPolicies, desired)
+	cr.Status.AtProvider.DriftedRules = drifts
+
+	if len(drifts) > 0 {
+		diff := strings.Join(drifts, "; ")
+		c.recorder.Event(cr, event.Normal(reasonDrift, diff))
+
 		return managed.ExternalObservation{
 			ResourceExists:    true,
 			ResourceUpToDate:  false,
+			Diff:              diff,
 			ConnectionDetails: managed.ConnectionDetails{},
 		}, nil
 	}
 
-	for oldPath, oldPolicy := range oldPoliciesAsMap {
-		if newPolicy, exist := currentPolicyAsMap[oldPath]; exist {
-			if policyChange(oldPolicy, newPolicy) {
-				return managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
-				}, nil
-			}
-		} else {
-			return managed.ExternalObservation{
-				ResourceExists:    true,
-				ResourceUpToDate:  false,
-				ConnectionDetails: managed.ConnectionDetails{},
-			}, nil
-		}
-	}
-
 	cr.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
@@ -225,24 +444,10 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotPolicy)
 	}
 
-	policyName := cr.ObjectMeta.Name
-
-	for _, rule := range cr.Spec.ForProvider.Rules {
-		path := rule.Path
-		capabilities := rule.Capabilities
+	err := c.put(ctx, cr)
 
-		policy := clients.Policy{
-			PathConfig: clients.PathConfig{
-				Path:         path,
-				Capabilities: capabilities,
-			},
-		}
-
-		err := c.service.Put(ctx, policyName, policy)
-
-		if err != nil {
-			return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, err
-		}
+	if err != nil {
+		return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, err
 	}
 
 	return managed.ExternalCreation{
@@ -256,24 +461,10 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotPolicy)
 	}
 
-	policyName := cr.ObjectMeta.Name
-
-	for _, rule := range cr.Spec.ForProvider.Rules {
-		path := rule.Path
-		capabilities := rule.Capabilities
+	err := c.put(ctx, cr)
 
-		policy := clients.Policy{
-			PathConfig: clients.PathConfig{
-				Path:         path,
-				Capabilities: capabilities,
-			},
-		}
-
-		err := c.service.Put(ctx, policyName, policy)
-
-		if err != nil {
-			return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}}, err
-		}
+	if err != nil {
+		return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}}, err
 	}
 
 	return managed.ExternalUpdate{
@@ -287,7 +478,7 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotPolicy)
 	}
 
-	err := c.service.Delete(ctx, cr.ObjectMeta.Name)
+	err := c.service.Delete(ctx, policyName(cr))
 
 	if err != nil {
 		return err
@@ -2,13 +2,19 @@ package policy
 
 import (
 	"context"
-	"errors"
+	"crypto/sha256"
+	"encoding/hex"
 	"github.com/golang/mock/gomock"
 	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
 	"github.com/munditrade/provider-secret/internal/clients"
 	"github.com/munditrade/provider-secret/internal/clients/exceptions"
+	"github.com/munditrade/provider-secret/internal/clients/vault"
+	"github.com/pkg/errors"
 	"testing"
+	"time"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -16,6 +22,12 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const invalidDocument = `path "dev" {
+  capabilities = ["read"
+}`
+
+var _, invalidDocumentErr = vault.ParseDocument(invalidDocument)
+
 func TestPolicy_Observe(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -93,6 +105,7 @@ func TestPolicy_Observe(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  false,
+					Diff:              `rule "dev": capabilities differ; rule "stg" is not part of the policy`,
 					ConnectionDetails: managed.ConnectionDetails{},
 				},
 				err: nil,
@@ -142,6 +155,7 @@ func TestPolicy_Observe(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  false,
+					Diff:              `rule "dev": capabilities differ`,
 					ConnectionDetails: managed.ConnectionDetails{},
 				},
 				err: nil,
@@ -164,6 +178,391 @@ func TestPolicy_Observe(t *testing.T) {
 					}, nil).AnyTimes()
 			},
 		},
+		"when every rule is already in vault the policy should be up to date": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Rules: []v1alpha1.Rule{
+								{
+									Path:         "dev",
+									Capabilities: []string{"list", "read"},
+								},
+								{
+									Path:         "stg",
+									Capabilities: []string{"read"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "dev",
+								Capabilities: []string{"list", "read"},
+							},
+						},
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "stg",
+								Capabilities: []string{"read"},
+							},
+						},
+					}, nil).AnyTimes()
+			},
+		},
+		"when rule parameters change should be updated the policies": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Rules: []v1alpha1.Rule{
+								{
+									Path:               "dev",
+									Capabilities:       []string{"create", "update"},
+									AllowedParameters:  map[string][]string{"Owner": {"team-a", "team-b"}},
+									RequiredParameters: []string{"owner"},
+									MaxWrappingTTL:     &v1.Duration{Duration: time.Hour},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              `rule "dev": allowed parameters differ`,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:               "dev",
+								Capabilities:       []string{"update", "create"},
+								AllowedParameters:  map[string][]string{"owner": {"team-a"}},
+								RequiredParameters: []string{"owner"},
+								MaxWrappingTTL:     time.Hour,
+							},
+						},
+					}, nil).AnyTimes()
+			},
+		},
+		"when rule parameters match in any order the policy should be up to date": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Rules: []v1alpha1.Rule{
+								{
+									Path:               "dev",
+									Capabilities:       []string{"create", "update"},
+									AllowedParameters:  map[string][]string{"Owner": {"team-a", "team-b"}},
+									RequiredParameters: []string{"owner"},
+									MaxWrappingTTL:     &v1.Duration{Duration: time.Hour},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:               "dev",
+								Capabilities:       []string{"update", "create"},
+								AllowedParameters:  map[string][]string{"owner": {"team-b", "team-a"}},
+								RequiredParameters: []string{"owner"},
+								MaxWrappingTTL:     time.Hour,
+							},
+						},
+					}, nil).AnyTimes()
+			},
+		},
+		"when the document matches vault the policy should be up to date": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Document: `
+# team access
+path "dev/*" {
+  capabilities = ["read", "list"]
+}`,
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "dev/*",
+								Capabilities: []string{"list", "read"},
+							},
+						},
+					}, nil).AnyTimes()
+			},
+		},
+		"when the document is not valid should return the parse error": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Document: invalidDocument,
+						},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(invalidDocumentErr, errParseDocument),
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").Return([]clients.Policy{}, nil).Times(1)
+			},
+		},
+		"when both rules and document are set should return an error": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Rules: []v1alpha1.Rule{
+								{
+									Path:         "dev",
+									Capabilities: []string{"read"},
+								},
+							},
+							Document: `path "dev" { capabilities = ["read"] }`,
+						},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.New(errRulesAndDocument),
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").Return([]clients.Policy{}, nil).Times(1)
+			},
+		},
+		"when the policy is deleted should not parse its document": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name:              "the-super-policy",
+						DeletionTimestamp: &v1.Time{Time: time.Now()},
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Document: invalidDocument,
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").Return([]clients.Policy{}, nil).Times(1)
+			},
+		},
+		"when the glob of a path changes should be updated the policies": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Rules: []v1alpha1.Rule{
+								{
+									Path:         "secret/foo/",
+									Capabilities: []string{"read"},
+								},
+								{
+									Path:         "secret/+/bar",
+									Capabilities: []string{"read"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              `rule "secret/+/bar" is missing in Vault; rule "secret/foo/" is missing in Vault; rule "secret/foo/*" is not part of the policy; rule "secret/team/bar" is not part of the policy`,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "secret/foo/*",
+								Capabilities: []string{"read"},
+							},
+						},
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "secret/team/bar",
+								Capabilities: []string{"read"},
+							},
+						},
+					}, nil).AnyTimes()
+			},
+		},
+		"when capabilities only differ in order or repetition the policy should be up to date": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Rules: []v1alpha1.Rule{
+								{
+									Path:         "/secret/foo/*",
+									Capabilities: []string{"read", "list", "read"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "secret/foo/*",
+								Capabilities: []string{"list", "read"},
+							},
+						},
+					}, nil).AnyTimes()
+			},
+		},
+		"when the external name is set should observe the vault policy with that name": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "team-admins",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "Team_Admins",
+						},
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Rules: []v1alpha1.Rule{
+								{
+									Path:         "sys/policies/*",
+									Capabilities: []string{"read"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "Team_Admins").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "sys/policies/*",
+								Capabilities: []string{"read"},
+							},
+						},
+					}, nil).Times(1)
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -174,7 +573,7 @@ func TestPolicy_Observe(t *testing.T) {
 
 			mock := clients.NewMockPolicyManager(ctrl)
 			testCase.prepareMock(mock)
-			e := external{service: mock}
+			e := external{service: mock, recorder: event.NewNopRecorder()}
 			got, err := e.Observe(testCase.args.ctx, testCase.args.mg)
 
 			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
@@ -234,16 +633,18 @@ func TestPolicy_Create(t *testing.T) {
 				err: nil,
 			},
 			prepareMock: func(m *clients.MockPolicyManager) {
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/dev",
-						Capabilities: []string{"list, read"},
+				m.EXPECT().Put(gomock.Any(), "the-super-policy", []clients.Policy{
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/dev",
+							Capabilities: []string{"list, read"},
+						},
 					},
-				}).Return(nil).Times(1)
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/stg",
-						Capabilities: []string{"read"},
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/stg",
+							Capabilities: []string{"read"},
+						},
 					},
 				}).Return(nil).Times(1)
 			},
@@ -276,20 +677,65 @@ func TestPolicy_Create(t *testing.T) {
 				err: errors.New("ups"),
 			},
 			prepareMock: func(m *clients.MockPolicyManager) {
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/dev",
-						Capabilities: []string{"list, read"},
+				m.EXPECT().Put(gomock.Any(), "the-super-policy", []clients.Policy{
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/dev",
+							Capabilities: []string{"list, read"},
+						},
 					},
-				}).Return(nil).Times(1)
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/stg",
-						Capabilities: []string{"read"},
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/stg",
+							Capabilities: []string{"read"},
+						},
 					},
 				}).Return(errors.New("ups")).Times(1)
 			},
 		},
+		"should write the raw document when the policy has one": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Document: `path "dev/*" { capabilities = ["read"] }`,
+						},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}},
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().PutDocument(gomock.Any(), "the-super-policy", `path "dev/*" { capabilities = ["read"] }`).
+					Return(nil).Times(1)
+			},
+		},
+		"should not write a document that cannot be parsed": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "the-super-policy",
+					},
+					Spec: v1alpha1.PolicySpec{
+						ForProvider: v1alpha1.PolicyParameters{
+							Document: invalidDocument,
+						},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}},
+				err: errors.Wrap(invalidDocumentErr, errParseDocument),
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {},
+		},
 	}
 
 	for name, tc := range cases {
@@ -300,7 +746,7 @@ func TestPolicy_Create(t *testing.T) {
 
 			mock := clients.NewMockPolicyManager(ctrl)
 			testCase.prepareMock(mock)
-			e := external{service: mock}
+			e := external{service: mock, recorder: event.NewNopRecorder()}
 			got, err := e.Create(testCase.args.ctx, testCase.args.mg)
 
 			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
@@ -360,16 +806,18 @@ func TestPolicy_Update(t *testing.T) {
 				err: nil,
 			},
 			prepareMock: func(m *clients.MockPolicyManager) {
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/dev",
-						Capabilities: []string{"list, read"},
+				m.EXPECT().Put(gomock.Any(), "the-super-policy", []clients.Policy{
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/dev",
+							Capabilities: []string{"list, read"},
+						},
 					},
-				}).Return(nil).Times(1)
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/stg",
-						Capabilities: []string{"read"},
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/stg",
+							Capabilities: []string{"read"},
+						},
 					},
 				}).Return(nil).Times(1)
 			},
@@ -402,16 +850,18 @@ func TestPolicy_Update(t *testing.T) {
 				err: errors.New("ups"),
 			},
 			prepareMock: func(m *clients.MockPolicyManager) {
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/dev",
-						Capabilities: []string{"list, read"},
+				m.EXPECT().Put(gomock.Any(), "the-super-policy", []clients.Policy{
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/dev",
+							Capabilities: []string{"list, read"},
+						},
 					},
-				}).Return(nil).Times(1)
-				m.EXPECT().Put(gomock.Any(), "the-super-policy", clients.Policy{
-					PathConfig: clients.PathConfig{
-						Path:         "/stg",
-						Capabilities: []string{"read"},
+					{
+						PathConfig: clients.PathConfig{
+							Path:         "/stg",
+							Capabilities: []string{"read"},
+						},
 					},
 				}).Return(errors.New("ups")).Times(1)
 			},
@@ -426,7 +876,7 @@ func TestPolicy_Update(t *testing.T) {
 
 			mock := clients.NewMockPolicyManager(ctrl)
 			testCase.prepareMock(mock)
-			e := external{service: mock}
+			e := external{service: mock, recorder: event.NewNopRecorder()}
 			got, err := e.Update(testCase.args.ctx, testCase.args.mg)
 
 			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
@@ -517,6 +967,25 @@ func TestPolicy_Delete(t *testing.T) {
 				m.EXPECT().Delete(gomock.Any(), "the-super-policy").Return(errors.New("ups")).Times(1)
 			},
 		},
+		"should delete the vault policy named by the external name": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.Policy{
+					ObjectMeta: v1.ObjectMeta{
+						Name: "team-admins",
+						Annotations: map[string]string{
+							meta.AnnotationKeyExternalName: "Team_Admins",
+						},
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Delete(gomock.Any(), "Team_Admins").Return(nil).Times(1)
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -527,7 +996,7 @@ func TestPolicy_Delete(t *testing.T) {
 
 			mock := clients.NewMockPolicyManager(ctrl)
 			testCase.prepareMock(mock)
-			e := external{service: mock}
+			e := external{service: mock, recorder: event.NewNopRecorder()}
 			err := e.Delete(testCase.args.ctx, testCase.args.mg)
 
 			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
@@ -536,3 +1005,106 @@ func TestPolicy_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicy_ObserveStatus(t *testing.T) {
+	rules := []v1alpha1.Rule{
+		{
+			Path:         "dev/*",
+			Capabilities: []string{"read"},
+		},
+	}
+	document := vault.RenderPolicy([]clients.Policy{
+		{
+			PathConfig: clients.PathConfig{
+				Path:         "dev/*",
+				Capabilities: []string{"read"},
+			},
+		},
+	})
+	hash := sha256.Sum256([]byte(document))
+	drifted := vault.RenderPolicy([]clients.Policy{
+		{
+			PathConfig: clients.PathConfig{
+				Path:         "dev/*",
+				Capabilities: []string{"list"},
+			},
+		},
+	})
+	driftedHash := sha256.Sum256([]byte(drifted))
+
+	type prepareMock func(m *clients.MockPolicyManager)
+
+	cases := map[string]struct {
+		reason      string
+		prepareMock prepareMock
+		want        v1alpha1.PolicyObservation
+	}{
+		"should record the applied document when the policy is up to date": {
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "dev/*",
+								Capabilities: []string{"read"},
+							},
+						},
+					}, nil).Times(1)
+			},
+			want: v1alpha1.PolicyObservation{
+				Name:         "the-super-policy",
+				Document:     document,
+				Hash:         hex.EncodeToString(hash[:]),
+				DriftedRules: []string{},
+			},
+		},
+		"should record the drifted rules and the document Vault holds when the policy is out of sync": {
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "the-super-policy").
+					Return([]clients.Policy{
+						{
+							PathConfig: clients.PathConfig{
+								Path:         "dev/*",
+								Capabilities: []string{"list"},
+							},
+						},
+					}, nil).Times(1)
+			},
+			want: v1alpha1.PolicyObservation{
+				Name:         "the-super-policy",
+				Document:     drifted,
+				Hash:         hex.EncodeToString(driftedHash[:]),
+				DriftedRules: []string{`rule "dev/*": capabilities differ`},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockPolicyManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, recorder: event.NewNopRecorder()}
+			cr := &v1alpha1.Policy{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "the-super-policy",
+				},
+				Spec: v1alpha1.PolicySpec{
+					ForProvider: v1alpha1.PolicyParameters{
+						Rules: rules,
+					},
+				},
+			}
+
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): unexpected error: %v\n", testCase.reason, err)
+			}
+			if diff := cmp.Diff(testCase.want, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,395 @@
+package policy
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/clients/exceptions"
+	"github.com/munditrade/provider-secret/internal/clients/vault"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotPolicySet  = "managed resource is not a PolicySet custom resource"
+	errSelector      = "cannot parse PolicySet selector"
+	errListFragments = "cannot list Policy fragments"
+	errNoFragments   = "selector does not match any Policy fragment"
+	errFragment      = "cannot read Policy fragment %s"
+
+	reasonNoFragments xpv1.ConditionReason = "NoFragments"
+)
+
+// SetupPolicySet adds a controller that reconciles PolicySet managed
+// resources.
+func SetupPolicySet(newPolicyManager clients.GetPolicyManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.PolicySetGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicySetGroupVersionKind),
+			managed.WithExternalConnecter(&setConnector{connector: connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				recorder:     recorder,
+				newServiceFn: newPolicyManager}}),
+			managed.WithLogger(o.Logger.WithValues("controller-policyset", name)),
+			managed.WithRecorder(recorder),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.PolicySet{}).
+			Watches(&source.Kind{Type: &v1alpha1.Policy{}}, enqueueFragmentSets(mgr.GetClient())).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+// fragmentSets maps a Policy to the PolicySets selecting it, so that changing
+// a fragment reconciles every set it belongs to.
+func fragmentSets(kube client.Reader) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		sets := &v1alpha1.PolicySetList{}
+		if err := kube.List(context.TODO(), sets); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0)
+
+		for i := range sets.Items {
+			selector, err := metav1.LabelSelectorAsSelector(&sets.Items[i].Spec.ForProvider.Selector)
+			if err != nil {
+				continue
+			}
+
+			if selector.Matches(labels.Set(obj.GetLabels())) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: sets.Items[i].GetName()}})
+			}
+		}
+
+		return requests
+	}
+}
+
+// enqueueFragmentSets enqueues the PolicySets selecting a Policy. When the
+// labels of the Policy change, the sets that selected it before the change
+// are enqueued too, so that they drop the fragment.
+func enqueueFragmentSets(kube client.Reader) handler.EventHandler {
+	sets := fragmentSets(kube)
+
+	enqueue := func(q workqueue.RateLimitingInterface, objs ...client.Object) {
+		for _, obj := range objs {
+			for _, r := range sets(obj) {
+				q.Add(r)
+			}
+		}
+	}
+
+	return handler.Funcs{
+		CreateFunc: func(e ctrlevent.CreateEvent, q workqueue.RateLimitingInterface) {
+			enqueue(q, e.Object)
+		},
+		UpdateFunc: func(e ctrlevent.UpdateEvent, q workqueue.RateLimitingInterface) {
+			enqueue(q, e.ObjectOld, e.ObjectNew)
+		},
+		DeleteFunc: func(e ctrlevent.DeleteEvent, q workqueue.RateLimitingInterface) {
+			enqueue(q, e.Object)
+		},
+		GenericFunc: func(e ctrlevent.GenericEvent, q workqueue.RateLimitingInterface) {
+			enqueue(q, e.Object)
+		},
+	}
+}
+
+type setConnector struct {
+	connector
+}
+
+func (c *setConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.PolicySet); !ok {
+		return nil, errors.New(errNotPolicySet)
+	}
+
+	svc, pc, err := c.connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setExternal{kube: c.kube, service: svc, recorder: c.recorder, lintRejections: lintRejections(pc)}, nil
+}
+
+type setExternal struct {
+	kube           client.Reader
+	service        clients.PolicyManager
+	recorder       event.Recorder
+	lintRejections []v1alpha12.PolicyLintFinding
+}
+
+// fragments returns the Policy fragments selected by the set, sorted by name
+// so that the composed document is stable. Fragments being deleted are left
+// out.
+func (c *setExternal) fragments(ctx context.Context, cr *v1alpha1.PolicySet) ([]v1alpha1.Policy, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&cr.Spec.ForProvider.Selector)
+	if err != nil {
+		return nil, errors.Wrap(err, errSelector)
+	}
+
+	list := &v1alpha1.PolicyList{}
+	if err := c.kube.List(ctx, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrap(err, errListFragments)
+	}
+
+	fragments := make([]v1alpha1.Policy, 0, len(list.Items))
+	for _, fragment := range list.Items {
+		if fragment.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		fragments = append(fragments, fragment)
+	}
+
+	sort.Slice(fragments, func(i, j int) bool {
+		return fragments[i].GetName() < fragments[j].GetName()
+	})
+
+	return fragments, nil
+}
+
+// compose returns the rules of every fragment as Vault is expected to store
+// them, along with the rules as they are declared for the linter.
+func compose(fragments []v1alpha1.Policy) ([]clients.PolicyFragment, []clients.Policy, error) {
+	composed := make([]clients.PolicyFragment, 0, len(fragments))
+	declared := make([]clients.Policy, 0)
+
+	for i := range fragments {
+		desired, err := desiredPolicies(&fragments[i])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, errFragment, fragments[i].GetName())
+		}
+
+		rules, err := declaredPolicies(&fragments[i])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, errFragment, fragments[i].GetName())
+		}
+
+		composed = append(composed, clients.PolicyFragment{Name: fragments[i].GetName(), Policies: desired})
+		declared = append(declared, rules...)
+	}
+
+	return composed, declared, nil
+}
+
+// flatten returns the rules of every fragment in order.
+func flatten(fragments []clients.PolicyFragment) []clients.Policy {
+	policies := make([]clients.Policy, 0)
+
+	for _, fragment := range fragments {
+		policies = append(policies, fragment.Policies...)
+	}
+
+	return policies
+}
+
+// setComposed records the fragments and the document Vault holds for the
+// PolicySet in its status.
+func setComposed(cr *v1alpha1.PolicySet, fragments []clients.PolicyFragment) {
+	names := make([]string, 0, len(fragments))
+	for _, fragment := range fragments {
+		names = append(names, fragment.Name)
+	}
+
+	doc := vault.RenderComposedPolicy(fragments)
+
+	cr.Status.AtProvider.Fragments = names
+	cr.Status.AtProvider.Document = doc
+	cr.Status.AtProvider.Hash = hash(doc)
+}
+
+// noFragments reports that the selector of the PolicySet matches no Policy,
+// so the policy Vault holds is left as it is.
+func noFragments() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonNoFragments,
+		Message:            errNoFragments,
+	}
+}
+
+// put writes the composed document of the PolicySet to Vault.
+func (c *setExternal) put(ctx context.Context, cr *v1alpha1.PolicySet) error {
+	fragments, err := c.fragments(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	if len(fragments) == 0 {
+		return errors.New(errNoFragments)
+	}
+
+	composed, declared, err := compose(fragments)
+	if err != nil {
+		return err
+	}
+
+	if err := lint(c.recorder, c.lintRejections, cr, declared); err != nil {
+		return err
+	}
+
+	if err := c.service.PutComposed(ctx, policyName(cr), composed); err != nil {
+		return err
+	}
+
+	setComposed(cr, composed)
+
+	return nil
+}
+
+func (c *setExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PolicySet)
+	var policyNotFoundErr *exceptions.NotFoundPolicy
+
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicySet)
+	}
+
+	name := policyName(cr)
+	oldPolicies, err := c.service.Get(ctx, name)
+
+	if errors.As(err, &policyNotFoundErr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "error getting policy")
+	}
+
+	cr.Status.AtProvider.Name = name
+
+	// The fragments of a deleted PolicySet are often deleted along with it,
+	// and they do not matter to deleting the policy from Vault.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	fragments, err := c.fragments(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if len(fragments) == 0 {
+		cr.SetConditions(noFragments())
+
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	composed, _, err := compose(fragments)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	drifts := policyDrift(oldPolicies, flatten(composed))
+	cr.Status.AtProvider.DriftedRules = drifts
+
+	if len(drifts) > 0 {
+		diff := strings.Join(drifts, "; ")
+		c.recorder.Event(cr, event.Normal(reasonDrift, diff))
+
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              diff,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	setComposed(cr, composed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *setExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PolicySet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicySet)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *setExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PolicySet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicySet)
+	}
+
+	if err := c.put(ctx, cr); err != nil {
+		return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *setExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PolicySet)
+	if !ok {
+		return errors.New(errNotPolicySet)
+	}
+
+	return c.service.Delete(ctx, policyName(cr))
+}
@@ -0,0 +1,306 @@
+package policy
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/clients/exceptions"
+)
+
+func fragment(name string, rules ...v1alpha1.Rule) v1alpha1.Policy {
+	return v1alpha1.Policy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"policy-set": "platform"},
+		},
+		Spec: v1alpha1.PolicySpec{
+			ForProvider: v1alpha1.PolicyParameters{
+				Rules: rules,
+			},
+		},
+	}
+}
+
+func listFragments(fragments ...v1alpha1.Policy) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1alpha1.PolicyList).Items = fragments
+		return nil
+	}
+}
+
+func TestPolicySet_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockPolicyManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		fragments   []string
+		reason      xpv1.ConditionReason
+		err         error
+	}
+
+	teamA := fragment("team-a", v1alpha1.Rule{Path: "kv/data/team-a/*", Capabilities: []string{"read"}})
+	teamB := fragment("team-b", v1alpha1.Rule{Path: "kv/data/team-b/*", Capabilities: []string{"read", "list"}})
+
+	broken := fragment("broken", v1alpha1.Rule{Path: "kv/*", Capabilities: []string{"read"}})
+	broken.Spec.ForProvider.Document = `path "kv/*" { capabilities = ["read"] }`
+
+	stored := []clients.Policy{
+		{PathConfig: clients.PathConfig{Path: "kv/data/team-a/*", Capabilities: []string{"read"}}},
+	}
+
+	cases := map[string]struct {
+		reason      string
+		deleted     bool
+		list        test.MockListFn
+		want        want
+		prepareMock prepareMock
+	}{
+		"should be up to date when Vault holds every fragment": {
+			list: listFragments(teamA),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				fragments: []string{"team-a"},
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "platform").Return(stored, nil).Times(1)
+			},
+		},
+		"should drift when a new fragment is selected": {
+			list: listFragments(teamB, teamA),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              `rule "kv/data/team-b/*" is missing in Vault`,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "platform").Return(stored, nil).Times(1)
+			},
+		},
+		"should report a condition when no fragment is selected": {
+			list: listFragments(),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				reason: reasonNoFragments,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "platform").Return(stored, nil).Times(1)
+			},
+		},
+		"should fail when fragments cannot be listed": {
+			list: test.NewMockListFn(errors.New("boom")),
+			want: want{
+				err: errors.Wrap(errors.New("boom"), errListFragments),
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "platform").Return(stored, nil).Times(1)
+			},
+		},
+		"should let a deleted set be deleted whatever its fragments": {
+			deleted: true,
+			list:    listFragments(broken, teamA),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "platform").Return(stored, nil).Times(1)
+			},
+		},
+		"should report a deleted set gone once Vault no longer holds its policy": {
+			deleted: true,
+			list:    test.NewMockListFn(errors.New("boom")),
+			want: want{
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "platform").Return(nil, exceptions.NewNotFoundPolicy("platform")).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockPolicyManager(ctrl)
+			testCase.prepareMock(mock)
+			e := setExternal{
+				kube:     &test.MockClient{MockList: testCase.list},
+				service:  mock,
+				recorder: event.NewNopRecorder(),
+			}
+			cr := &v1alpha1.PolicySet{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "platform",
+				},
+				Spec: v1alpha1.PolicySetSpec{
+					ForProvider: v1alpha1.PolicySetParameters{
+						Selector: v1.LabelSelector{MatchLabels: map[string]string{"policy-set": "platform"}},
+					},
+				},
+			}
+
+			if testCase.deleted {
+				now := v1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}
+
+			got, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if testCase.want.reason != "" {
+				if diff := cmp.Diff(testCase.want.reason, cr.GetCondition(xpv1.TypeReady).Reason); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want reason, +got reason:\n%s\n", testCase.reason, diff)
+				}
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.fragments, cr.Status.AtProvider.Fragments); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want fragments, +got fragments:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPolicySet_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamA := fragment("team-a", v1alpha1.Rule{Path: "kv/data/team-a/*", Capabilities: []string{"read"}})
+	teamB := fragment("team-b", v1alpha1.Rule{Path: "kv/data/team-b/*", Capabilities: []string{"deny", "read"}})
+
+	want := []clients.PolicyFragment{
+		{Name: "team-a", Policies: []clients.Policy{
+			{PathConfig: clients.PathConfig{Path: "kv/data/team-a/*", Capabilities: []string{"read"}}},
+		}},
+		{Name: "team-b", Policies: []clients.Policy{
+			{PathConfig: clients.PathConfig{Path: "kv/data/team-b/*", Capabilities: []string{"deny"}}},
+		}},
+	}
+
+	mock := clients.NewMockPolicyManager(ctrl)
+	mock.EXPECT().PutComposed(gomock.Any(), "platform", want).Return(nil).Times(1)
+
+	e := setExternal{
+		kube:     &test.MockClient{MockList: listFragments(teamB, teamA)},
+		service:  mock,
+		recorder: event.NewNopRecorder(),
+	}
+	cr := &v1alpha1.PolicySet{ObjectMeta: v1.ObjectMeta{Name: "platform"}}
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"team-a", "team-b"}, cr.Status.AtProvider.Fragments); diff != "" {
+		t.Errorf("e.Create(...): -want fragments, +got fragments:\n%s\n", diff)
+	}
+}
+
+func TestPolicySet_FragmentSets(t *testing.T) {
+	sets := []v1alpha1.PolicySet{
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "platform"},
+			Spec: v1alpha1.PolicySetSpec{ForProvider: v1alpha1.PolicySetParameters{
+				Selector: v1.LabelSelector{MatchLabels: map[string]string{"policy-set": "platform"}},
+			}},
+		},
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "billing"},
+			Spec: v1alpha1.PolicySetSpec{ForProvider: v1alpha1.PolicySetParameters{
+				Selector: v1.LabelSelector{MatchLabels: map[string]string{"policy-set": "billing"}},
+			}},
+		},
+	}
+
+	kube := &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1alpha1.PolicySetList).Items = sets
+		return nil
+	}}
+
+	p := fragment("team-a")
+	got := fragmentSets(kube)(&p)
+	want := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "platform"}}}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("fragmentSets(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestPolicySet_EnqueueFragmentSets(t *testing.T) {
+	sets := []v1alpha1.PolicySet{
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "platform"},
+			Spec: v1alpha1.PolicySetSpec{ForProvider: v1alpha1.PolicySetParameters{
+				Selector: v1.LabelSelector{MatchLabels: map[string]string{"policy-set": "platform"}},
+			}},
+		},
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "billing"},
+			Spec: v1alpha1.PolicySetSpec{ForProvider: v1alpha1.PolicySetParameters{
+				Selector: v1.LabelSelector{MatchLabels: map[string]string{"policy-set": "billing"}},
+			}},
+		},
+	}
+
+	kube := &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1alpha1.PolicySetList).Items = sets
+		return nil
+	}}
+
+	old := fragment("team-a")
+	moved := fragment("team-a")
+	moved.SetLabels(map[string]string{"policy-set": "billing"})
+
+	q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+
+	enqueueFragmentSets(kube).Update(ctrlevent.UpdateEvent{ObjectOld: &old, ObjectNew: &moved}, q)
+
+	got := make([]string, 0, q.Len())
+	for q.Len() > 0 {
+		item, _ := q.Get()
+		got = append(got, item.(reconcile.Request).Name)
+		q.Done(item)
+	}
+	sort.Strings(got)
+
+	if diff := cmp.Diff([]string{"billing", "platform"}, got); diff != "" {
+		t.Errorf("enqueueFragmentSets(...): -want, +got:\n%s\n", diff)
+	}
+}
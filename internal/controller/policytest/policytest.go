@@ -0,0 +1,271 @@
+package policytest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/clients/exceptions"
+	"github.com/munditrade/provider-secret/internal/clients/vault"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotPolicyTest = "managed resource is not a PolicyTest custom resource"
+	errTrackPCUsage  = "cannot track ProviderConfig usage"
+	errGetPC         = "cannot get ProviderConfig"
+	errNoSecretRef   = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret     = "cannot get credentials Secret"
+	errGetPolicy     = "cannot get policy %s"
+	errBuildACL      = "cannot evaluate policies"
+	errMissingPolicy = "policies %s do not exist"
+
+	errNewClient = "cannot create new Service"
+
+	reasonMissingPolicies xpv1.ConditionReason = "MissingPolicies"
+)
+
+// Setup adds a controller that reconciles PolicyTest managed resources.
+func Setup(newPolicyManager clients.GetPolicyManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.PolicyTestGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyTestGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newPolicyManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-policytest", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.PolicyTest{}).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetPolicyManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTest)
+	if !ok {
+		return nil, errors.New(errNotPolicyTest)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+// An external evaluates the assertions of a PolicyTest. It never writes to
+// Vault, so there is nothing to create, update or delete.
+type external struct {
+	service clients.PolicyManager
+}
+
+func contains(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate checks an assertion against the capabilities the ACL grants on its
+// path.
+func evaluate(ctx context.Context, acl *vault.ACL, assertion v1alpha1.PolicyAssertion) v1alpha1.PolicyAssertionResult {
+	capabilities := acl.Capabilities(ctx, assertion.Path)
+
+	result := v1alpha1.AssertionDenied
+	if contains(capabilities, assertion.Capability) || contains(capabilities, "root") {
+		result = v1alpha1.AssertionAllowed
+	}
+
+	return v1alpha1.PolicyAssertionResult{
+		Path:         assertion.Path,
+		Capability:   assertion.Capability,
+		Expect:       assertion.Expect,
+		Result:       result,
+		Capabilities: capabilities,
+		Passed:       result == assertion.Expect,
+	}
+}
+
+// missingPolicies reports that some policies of the PolicyTest do not exist
+// in Vault, so none of its assertions can be evaluated.
+func missingPolicies(names []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonMissingPolicies,
+		Message:            fmt.Sprintf(errMissingPolicy, strings.Join(names, ", ")),
+	}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTest)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicyTest)
+	}
+
+	// Nothing exists in Vault for a PolicyTest, so a deleted one is gone.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	policies := make([][]clients.Policy, 0, len(cr.Spec.ForProvider.Policies))
+	missing := make([]string, 0)
+
+	for _, name := range cr.Spec.ForProvider.Policies {
+		policy, err := c.service.Get(ctx, name)
+
+		var notFound *exceptions.NotFoundPolicy
+		if errors.As(err, &notFound) {
+			missing = append(missing, name)
+			continue
+		}
+
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrapf(err, errGetPolicy, name)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	// Every assertion fails until the missing policies are written.
+	if len(missing) > 0 {
+		cr.Status.AtProvider.Results = nil
+		cr.Status.AtProvider.Failed = len(cr.Spec.ForProvider.Assertions)
+		cr.SetConditions(missingPolicies(missing))
+
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	acl, err := vault.NewACL(ctx, policies...)
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBuildACL)
+	}
+
+	results := make([]v1alpha1.PolicyAssertionResult, 0, len(cr.Spec.ForProvider.Assertions))
+	failures := make([]string, 0)
+
+	for _, assertion := range cr.Spec.ForProvider.Assertions {
+		result := evaluate(ctx, acl, assertion)
+		results = append(results, result)
+
+		if !result.Passed {
+			failures = append(failures, fmt.Sprintf("%s on %q is %s, expected %s",
+				result.Capability, result.Path, strings.ToLower(result.Result), strings.ToLower(result.Expect)))
+		}
+	}
+
+	cr.Status.AtProvider.Results = results
+	cr.Status.AtProvider.Failed = len(failures)
+
+	if len(failures) > 0 {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(strings.Join(failures, "; ")))
+	} else {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if _, ok := mg.(*v1alpha1.PolicyTest); !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicyTest)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.PolicyTest); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicyTest)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if _, ok := mg.(*v1alpha1.PolicyTest); !ok {
+		return errors.New(errNotPolicyTest)
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package policytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/clients/exceptions"
+)
+
+func TestPolicyTest_Observe(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type prepareMock func(m *clients.MockPolicyManager)
+
+	type want struct {
+		o      managed.ExternalObservation
+		failed int
+		reason xpv1.ConditionReason
+		err    error
+	}
+
+	errBoom := errors.New("boom")
+
+	teamA := []clients.Policy{
+		{
+			PathConfig: clients.PathConfig{
+				Path:         "kv/data/team-a/*",
+				Capabilities: []string{"read", "update"},
+			},
+		},
+	}
+	denyDB := []clients.Policy{
+		{
+			PathConfig: clients.PathConfig{
+				Path:         "kv/data/team-a/db",
+				Capabilities: []string{"deny"},
+			},
+		},
+	}
+
+	policyTest := func(assertions ...v1alpha1.PolicyAssertion) *v1alpha1.PolicyTest {
+		return &v1alpha1.PolicyTest{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "team-a-access",
+			},
+			Spec: v1alpha1.PolicyTestSpec{
+				ForProvider: v1alpha1.PolicyTestParameters{
+					Policies:   []string{"team-a", "deny-db"},
+					Assertions: assertions,
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		reason      string
+		args        args
+		want        want
+		prepareMock prepareMock
+	}{
+		"when every assertion holds the test should pass": {
+			args: args{
+				ctx: context.Background(),
+				mg: policyTest(
+					v1alpha1.PolicyAssertion{Path: "kv/data/team-a/app", Capability: "update", Expect: v1alpha1.AssertionAllowed},
+					v1alpha1.PolicyAssertion{Path: "kv/data/team-a/db", Capability: "read", Expect: v1alpha1.AssertionDenied},
+					v1alpha1.PolicyAssertion{Path: "kv/data/team-b/app", Capability: "read", Expect: v1alpha1.AssertionDenied},
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				failed: 0,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "team-a").Return(teamA, nil).Times(1)
+				m.EXPECT().Get(gomock.Any(), "deny-db").Return(denyDB, nil).Times(1)
+			},
+		},
+		"when an assertion does not hold the test should fail": {
+			args: args{
+				ctx: context.Background(),
+				mg: policyTest(
+					v1alpha1.PolicyAssertion{Path: "kv/data/team-a/db", Capability: "update", Expect: v1alpha1.AssertionAllowed},
+					v1alpha1.PolicyAssertion{Path: "kv/data/team-a/app", Capability: "delete", Expect: v1alpha1.AssertionAllowed},
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				failed: 2,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "team-a").Return(teamA, nil).Times(1)
+				m.EXPECT().Get(gomock.Any(), "deny-db").Return(denyDB, nil).Times(1)
+			},
+		},
+		"when a policy does not exist the test should fail": {
+			args: args{
+				ctx: context.Background(),
+				mg: policyTest(
+					v1alpha1.PolicyAssertion{Path: "kv/data/team-a/app", Capability: "update", Expect: v1alpha1.AssertionAllowed},
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				failed: 1,
+				reason: reasonMissingPolicies,
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "team-a").Return(nil, exceptions.NewNotFoundPolicy("team-a")).Times(1)
+				m.EXPECT().Get(gomock.Any(), "deny-db").Return(denyDB, nil).Times(1)
+			},
+		},
+		"when a policy cannot be read should return an error": {
+			args: args{
+				ctx: context.Background(),
+				mg:  policyTest(),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrapf(errBoom, errGetPolicy, "team-a"),
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {
+				m.EXPECT().Get(gomock.Any(), "team-a").Return(nil, errBoom).Times(1)
+			},
+		},
+		"when the test is deleted it should be gone without reading its policies": {
+			args: args{
+				ctx: context.Background(),
+				mg: func() *v1alpha1.PolicyTest {
+					cr := policyTest()
+					cr.SetDeletionTimestamp(&v1.Time{Time: time.Now()})
+					return cr
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockPolicyManager) {},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockPolicyManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			got, err := e.Observe(testCase.args.ctx, testCase.args.mg)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			cr := testCase.args.mg.(*v1alpha1.PolicyTest)
+			if diff := cmp.Diff(testCase.want.failed, cr.Status.AtProvider.Failed); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want failed, +got failed:\n%s\n", testCase.reason, diff)
+			}
+			if testCase.want.reason != "" {
+				if diff := cmp.Diff(testCase.want.reason, cr.GetCondition(xpv1.TypeReady).Reason); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want reason, +got reason:\n%s\n", testCase.reason, diff)
+				}
+			}
+		})
+	}
+}
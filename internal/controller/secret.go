@@ -4,9 +4,19 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	vaultV1alpha "github.com/munditrade/provider-secret/apis/vault/v1alpha1"
 	"github.com/munditrade/provider-secret/internal/clients/vault"
+	"github.com/munditrade/provider-secret/internal/controller/approlerole"
+	"github.com/munditrade/provider-secret/internal/controller/authbackend"
+	"github.com/munditrade/provider-secret/internal/controller/certauthrole"
 	"github.com/munditrade/provider-secret/internal/controller/engine"
+	"github.com/munditrade/provider-secret/internal/controller/jwtauthconfig"
+	"github.com/munditrade/provider-secret/internal/controller/jwtauthrole"
+	"github.com/munditrade/provider-secret/internal/controller/kubernetesauthconfig"
+	"github.com/munditrade/provider-secret/internal/controller/kubernetesauthrole"
 	"github.com/munditrade/provider-secret/internal/controller/policy"
+	"github.com/munditrade/provider-secret/internal/controller/policytest"
 	"github.com/munditrade/provider-secret/internal/controller/secretpath"
+	"github.com/munditrade/provider-secret/internal/controller/tokenrole"
+	"github.com/munditrade/provider-secret/internal/controller/userpassuser"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/munditrade/provider-secret/internal/controller/config"
@@ -19,6 +29,17 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		engine.Setup(vault.New, &vaultV1alpha.Engine{}),
 		secretpath.Setup(vault.New, &vaultV1alpha.SecretPath{}),
 		policy.Setup(vault.NewVaultPolicyManager),
+		policy.SetupPolicySet(vault.NewVaultPolicyManager),
+		policytest.Setup(vault.NewVaultPolicyManager),
+		authbackend.Setup(vault.NewVaultAuthManager),
+		kubernetesauthconfig.Setup(vault.NewVaultAuthManager),
+		kubernetesauthrole.Setup(vault.NewVaultAuthManager),
+		approlerole.Setup(vault.NewVaultAuthManager),
+		jwtauthconfig.Setup(vault.NewVaultAuthManager),
+		jwtauthrole.Setup(vault.NewVaultAuthManager),
+		userpassuser.Setup(vault.NewVaultAuthManager),
+		certauthrole.Setup(vault.NewVaultAuthManager),
+		tokenrole.Setup(vault.NewVaultAuthManager),
 		config.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
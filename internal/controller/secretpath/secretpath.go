@@ -32,6 +32,7 @@ const (
 	errCreatingPath       = "error during path creation"
 	errNoSecretRef        = "ProviderConfig does not reference a credentials Secret"
 	errNewClient          = "cannot create new Service"
+	errEngineUpgrading    = "engine %s is being upgraded"
 )
 
 var emptyMap = map[string]interface{}{
@@ -111,6 +112,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	return &external{service: svc, kubeReader: c.kubeAPI}, nil
 }
 
+// blockOnUpgrade holds the SecretPath back while its engine is upgraded to kv
+// version 2, since the mount rejects requests until the upgrade is over.
+func blockOnUpgrade(cr *v1alpha1.SecretPath, engine *v1alpha1.Engine) error {
+	if engine.GetCondition(v1alpha1.TypeUpgrading).Status == corev1.ConditionTrue {
+		cr.SetConditions(v1alpha1.Upgrading(fmt.Sprintf("engine %s is being upgraded to kv version 2", engine.GetName())))
+		return errors.Errorf(errEngineUpgrading, engine.GetName())
+	}
+
+	if cr.GetCondition(v1alpha1.TypeUpgrading).Status == corev1.ConditionTrue {
+		cr.SetConditions(v1alpha1.UpgradeComplete())
+	}
+
+	return nil
+}
+
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	cr, ok := mg.(*v1alpha1.SecretPath)
 	if !ok {
@@ -127,10 +143,14 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 
-	storage := engine.ObjectMeta.Name
+	if err := blockOnUpgrade(cr, engine); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	storage := common.MountedPath(engine)
 	path := cr.Spec.ForProvider.Path
 
-	_, getSecretsErr := c.service.GetSecrets(ctx, storage, path, engine.Spec.ForProvider.Options)
+	_, getSecretsErr := c.service.GetSecrets(ctx, storage, path, common.MountOptions(engine.Spec.ForProvider))
 
 	if getSecretsErr != nil {
 		if getSecretsErr.Error() == common.ErrNotFoundPath {
@@ -165,9 +185,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, err
 	}
 
-	storage := engine.ObjectMeta.Name
+	storage := common.MountedPath(engine)
 	path := cr.Spec.ForProvider.Path
-	engineOpts := engine.Spec.ForProvider.Options
+	engineOpts := common.MountOptions(engine.Spec.ForProvider)
 
 	if putErr := c.service.Put(ctx, storage, path, emptyMap, engineOpts); putErr != nil {
 		return managed.ExternalCreation{}, errors.New(errCreatingPath)
@@ -207,9 +227,9 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return err
 	}
 
-	storage := engine.ObjectMeta.Name
+	storage := common.MountedPath(engine)
 	path := cr.Spec.ForProvider.Path
-	engineOpts := engine.Spec.ForProvider.Options
+	engineOpts := common.MountOptions(engine.Spec.ForProvider)
 
 	if err := c.service.DeletePath(ctx, storage, path, engineOpts); err != nil {
 		if err.Error() == common.ErrNotFoundPath {
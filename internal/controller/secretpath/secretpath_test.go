@@ -75,6 +75,114 @@ func TestSecretPath_Observe(t *testing.T) {
 					Return(nil, errors.New(common.ErrNotFoundPath)).AnyTimes()
 			},
 		},
+		"when engine is mounted at a nested path should read secrets from it": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.SecretPath{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      secretPathResourceName,
+						Namespace: ns,
+					},
+					Spec: v1alpha1.SecretPathSpec{
+						ForProvider: v1alpha1.SecretPathParameters{
+							Engine: engine,
+							Path:   path,
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager, reader *common.MockK8sReader) {
+				reader.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: ns, Name: engine}, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj *v1alpha1.Engine) error {
+						obj.ObjectMeta.Name = "test-engine"
+						obj.Spec.ForProvider.Path = "apps/prod/secrets"
+						obj.Spec.ForProvider.Options = map[string]string{}
+						return nil
+					})
+				m.EXPECT().GetSecrets(gomock.Any(), "apps/prod/secrets", path, map[string]string{}).
+					Return(map[string]interface{}{}, nil).Times(1)
+			},
+		},
+		"when engine is being remounted should read secrets from the path it is still mounted at": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.SecretPath{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      secretPathResourceName,
+						Namespace: ns,
+					},
+					Spec: v1alpha1.SecretPathSpec{
+						ForProvider: v1alpha1.SecretPathParameters{
+							Engine: engine,
+							Path:   path,
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+			prepareMock: func(m *common.MockSecretManager, reader *common.MockK8sReader) {
+				reader.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: ns, Name: engine}, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj *v1alpha1.Engine) error {
+						obj.ObjectMeta.Name = "test-engine"
+						obj.Spec.ForProvider.Path = "apps/prod/secrets"
+						obj.Spec.ForProvider.Options = map[string]string{}
+						obj.Status.AtProvider.Path = "apps/secrets"
+						obj.Status.AtProvider.Remount = &v1alpha1.EngineRemount{
+							From:        "apps/secrets",
+							To:          "apps/prod/secrets",
+							MigrationID: "migration-1",
+							Status:      v1alpha1.RemountInProgress,
+						}
+						return nil
+					})
+				m.EXPECT().GetSecrets(gomock.Any(), "apps/secrets", path, map[string]string{}).
+					Return(map[string]interface{}{}, nil).Times(1)
+			},
+		},
+		"when engine is being upgraded should block the path": {
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.SecretPath{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      secretPathResourceName,
+						Namespace: ns,
+					},
+					Spec: v1alpha1.SecretPathSpec{
+						ForProvider: v1alpha1.SecretPathParameters{
+							Engine: engine,
+							Path:   path,
+						},
+					},
+				},
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Errorf(errEngineUpgrading, engine),
+			},
+			prepareMock: func(m *common.MockSecretManager, reader *common.MockK8sReader) {
+				reader.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: ns, Name: engine}, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj *v1alpha1.Engine) error {
+						obj.ObjectMeta.Name = "test-engine"
+						obj.SetConditions(v1alpha1.Upgrading("upgrading test-engine to kv version 2"))
+						return nil
+					})
+			},
+		},
 		"when engine exist and path exists should not queue to create a new path": {
 			args: args{
 				ctx: context.Background(),
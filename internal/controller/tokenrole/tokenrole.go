@@ -0,0 +1,276 @@
+package tokenrole
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotTokenRole = "managed resource is not a TokenRole custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errNoSecretRef  = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret    = "cannot get credentials Secret"
+	errGetRole      = "cannot get token role"
+	errPutRole      = "cannot write token role"
+	errDeleteRole   = "cannot delete token role"
+
+	errNewClient = "cannot create new Service"
+)
+
+// Setup adds a controller that reconciles TokenRole managed resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.TokenRoleGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TokenRoleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-tokenrole", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.TokenRole{}).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TokenRole)
+	if !ok {
+		return nil, errors.New(errNotTokenRole)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc}, nil
+}
+
+type external struct {
+	service clients.AuthManager
+}
+
+// roleName returns the name of the role in Vault: the external name of the
+// TokenRole, falling back to its name.
+func roleName(cr *v1alpha1.TokenRole) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+
+	return cr.GetName()
+}
+
+// tokenRole returns the role declared by the TokenRole.
+func tokenRole(params v1alpha1.TokenRoleParameters) clients.TokenRole {
+	role := clients.TokenRole{
+		AllowedPolicies:        params.AllowedPolicies,
+		DisallowedPolicies:     params.DisallowedPolicies,
+		AllowedPoliciesGlob:    params.AllowedPoliciesGlob,
+		DisallowedPoliciesGlob: params.DisallowedPoliciesGlob,
+		Orphan:                 params.Orphan,
+		Renewable:              params.Renewable,
+		Type:                   params.TokenType,
+		BoundCIDRs:             params.TokenBoundCIDRs,
+	}
+
+	if params.TokenPeriod != nil {
+		role.Period = params.TokenPeriod.Duration
+	}
+
+	if params.TokenExplicitMaxTTL != nil {
+		role.ExplicitMaxTTL = params.TokenExplicitMaxTTL.Duration
+	}
+
+	return role
+}
+
+// roleDrift compares the role Vault holds with the declared one. Settings
+// left unset are not managed, and never count as drift. Policy names are
+// compared the way Vault stores them.
+func roleDrift(params v1alpha1.TokenRoleParameters, current *clients.TokenRole) []string {
+	desired := tokenRole(params)
+	drifts := make([]string, 0)
+
+	if len(desired.AllowedPolicies) > 0 && !common.SameSet(common.SanitizePolicies(desired.AllowedPolicies), common.SanitizePolicies(current.AllowedPolicies)) {
+		drifts = append(drifts, "allowed policies differ")
+	}
+
+	if len(desired.DisallowedPolicies) > 0 && !common.SameSet(common.SanitizePolicies(desired.DisallowedPolicies), common.SanitizePolicies(current.DisallowedPolicies)) {
+		drifts = append(drifts, "disallowed policies differ")
+	}
+
+	if len(desired.AllowedPoliciesGlob) > 0 && !common.SameSet(common.SanitizePolicies(desired.AllowedPoliciesGlob), common.SanitizePolicies(current.AllowedPoliciesGlob)) {
+		drifts = append(drifts, "allowed policy globs differ")
+	}
+
+	if len(desired.DisallowedPoliciesGlob) > 0 && !common.SameSet(common.SanitizePolicies(desired.DisallowedPoliciesGlob), common.SanitizePolicies(current.DisallowedPoliciesGlob)) {
+		drifts = append(drifts, "disallowed policy globs differ")
+	}
+
+	if desired.Orphan != current.Orphan {
+		drifts = append(drifts, "orphan differs")
+	}
+
+	if desired.Renewable != nil && (current.Renewable == nil || *desired.Renewable != *current.Renewable) {
+		drifts = append(drifts, "renewable differs")
+	}
+
+	if params.TokenPeriod != nil && desired.Period != current.Period {
+		drifts = append(drifts, "token period differs")
+	}
+
+	if params.TokenExplicitMaxTTL != nil && desired.ExplicitMaxTTL != current.ExplicitMaxTTL {
+		drifts = append(drifts, "token explicit max TTL differs")
+	}
+
+	if desired.Type != "" && desired.Type != current.Type {
+		drifts = append(drifts, "token type differs")
+	}
+
+	if len(desired.BoundCIDRs) > 0 && !common.SameSet(desired.BoundCIDRs, current.BoundCIDRs) {
+		drifts = append(drifts, "token bound CIDRs differ")
+	}
+
+	return drifts
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TokenRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTokenRole)
+	}
+
+	role, err := c.service.GetTokenRole(ctx, roleName(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRole)
+	}
+
+	if role == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.Status.AtProvider.Path = fmt.Sprintf("auth/token/roles/%s", roleName(cr))
+
+	if drifts := roleDrift(cr.Spec.ForProvider, role); len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TokenRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTokenRole)
+	}
+
+	if err := c.service.PutTokenRole(ctx, roleName(cr), tokenRole(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPutRole)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TokenRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTokenRole)
+	}
+
+	if err := c.service.PutTokenRole(ctx, roleName(cr), tokenRole(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPutRole)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TokenRole)
+	if !ok {
+		return errors.New(errNotTokenRole)
+	}
+
+	return errors.Wrap(c.service.DeleteTokenRole(ctx, roleName(cr)), errDeleteRole)
+}
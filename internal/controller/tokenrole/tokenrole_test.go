@@ -0,0 +1,213 @@
+package tokenrole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+)
+
+func role(params v1alpha1.TokenRoleParameters) *v1alpha1.TokenRole {
+	return &v1alpha1.TokenRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "orchestrator",
+		},
+		Spec: v1alpha1.TokenRoleSpec{
+			ForProvider: params,
+		},
+	}
+}
+
+func TestTokenRole_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		path        string
+		err         error
+	}
+
+	renewable := true
+
+	params := v1alpha1.TokenRoleParameters{
+		AllowedPolicies:     []string{"deploy", "read"},
+		AllowedPoliciesGlob: []string{"deploy-*"},
+		Orphan:              true,
+		Renewable:           &renewable,
+		TokenPeriod:         &v1.Duration{Duration: 24 * time.Hour},
+	}
+
+	current := &clients.TokenRole{
+		AllowedPolicies:        []string{"read", "deploy"},
+		DisallowedPolicies:     []string{"root"},
+		AllowedPoliciesGlob:    []string{"deploy-*"},
+		DisallowedPoliciesGlob: []string{},
+		Orphan:                 true,
+		Renewable:              &renewable,
+		Period:                 24 * time.Hour,
+		Type:                   "default-service",
+	}
+
+	cases := map[string]struct {
+		reason      string
+		params      v1alpha1.TokenRoleParameters
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when Vault has no such role": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetTokenRole(gomock.Any(), "orchestrator").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date ignoring the settings left unset": {
+			params: params,
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/token/roles/orchestrator",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetTokenRole(gomock.Any(), "orchestrator").Return(current, nil).Times(1)
+			},
+		},
+		"should compare policies the way Vault stores them": {
+			params: v1alpha1.TokenRoleParameters{
+				AllowedPolicies:     []string{"Deploy", " read", "deploy"},
+				DisallowedPolicies:  []string{"ROOT"},
+				AllowedPoliciesGlob: []string{"Deploy-*"},
+				Orphan:              true,
+			},
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/token/roles/orchestrator",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetTokenRole(gomock.Any(), "orchestrator").Return(current, nil).Times(1)
+			},
+		},
+		"should report the settings that drifted": {
+			params: v1alpha1.TokenRoleParameters{
+				AllowedPolicies:        []string{"deploy"},
+				DisallowedPoliciesGlob: []string{"admin-*"},
+				TokenExplicitMaxTTL:    &v1.Duration{Duration: 720 * time.Hour},
+				TokenType:              "batch",
+			},
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff: "allowed policies differ; disallowed policy globs differ; orphan differs; " +
+						"token explicit max TTL differs; token type differs",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				path: "auth/token/roles/orchestrator",
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetTokenRole(gomock.Any(), "orchestrator").Return(current, nil).Times(1)
+			},
+		},
+		"should fail when the role cannot be read": {
+			params: params,
+			want: want{
+				err: errors.Wrap(errors.New("boom"), errGetRole),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetTokenRole(gomock.Any(), "orchestrator").Return(nil, errors.New("boom")).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock}
+			cr := role(testCase.params)
+
+			got, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.path, cr.Status.AtProvider.Path); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want path, +got path:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTokenRole_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	renewable := false
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutTokenRole(gomock.Any(), "ci", clients.TokenRole{
+		AllowedPolicies:     []string{"deploy"},
+		AllowedPoliciesGlob: []string{"deploy-*"},
+		Renewable:           &renewable,
+		ExplicitMaxTTL:      time.Hour,
+		Type:                "batch",
+	}).Return(nil).Times(1)
+
+	e := external{service: mock}
+	cr := role(v1alpha1.TokenRoleParameters{
+		AllowedPolicies:     []string{"deploy"},
+		AllowedPoliciesGlob: []string{"deploy-*"},
+		Renewable:           &renewable,
+		TokenExplicitMaxTTL: &v1.Duration{Duration: time.Hour},
+		TokenType:           "batch",
+	})
+	meta.SetExternalName(cr, "ci")
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+}
+
+func TestTokenRole_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().DeleteTokenRole(gomock.Any(), "orchestrator").Return(nil).Times(1)
+
+	e := external{service: mock}
+
+	if err := e.Delete(context.Background(), role(v1alpha1.TokenRoleParameters{})); err != nil {
+		t.Errorf("e.Delete(...): unexpected error: %v", err)
+	}
+}
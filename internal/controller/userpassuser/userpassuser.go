@@ -0,0 +1,437 @@
+package userpassuser
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha12 "github.com/munditrade/provider-secret/apis/secret/v1alpha1"
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+	"github.com/munditrade/provider-secret/internal/controller/features"
+)
+
+const (
+	errNotUserpassUser  = "managed resource is not a UserpassUser custom resource"
+	errTrackPCUsage     = "cannot track ProviderConfig usage"
+	errGetPC            = "cannot get ProviderConfig"
+	errNoSecretRef      = "ProviderConfig does not reference a credentials Secret"
+	errGetSecret        = "cannot get credentials Secret"
+	errGetPassword      = "cannot get password"
+	errEmptyPassword    = "Secret %s/%s has an empty key %s"
+	errGeneratePassword = "cannot generate password"
+	errGetUser          = "cannot get userpass user"
+	errPutUser          = "cannot write userpass user"
+	errDeleteUser       = "cannot delete userpass user"
+
+	errNewClient = "cannot create new Service"
+
+	diffRotationRequested = "password rotation requested"
+	diffPasswordChanged   = "password Secret changed"
+	diffPasswordMissing   = "password is not published"
+
+	defaultMount = v1alpha1.AuthUserpass
+
+	passwordChars         = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	defaultPasswordLength = 32
+)
+
+// Setup adds a controller that reconciles UserpassUser managed resources.
+func Setup(newAuthManager clients.GetAuthManager) func(mgr ctrl.Manager, o controller.Options) error {
+	return func(mgr ctrl.Manager, o controller.Options) error {
+		name := managed.ControllerName(v1alpha1.UserpassUserGroupKind)
+
+		cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+		if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+			cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha12.StoreConfigGroupVersionKind))
+		}
+
+		r := managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.UserpassUserGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:         mgr.GetClient(),
+				usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &v1alpha12.ProviderConfigUsage{}),
+				newServiceFn: newAuthManager}),
+			managed.WithLogger(o.Logger.WithValues("controller-userpassuser", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...))
+
+		return ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(&v1alpha1.UserpassUser{}).
+			Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(secretUsers(mgr.GetClient()))).
+			Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	}
+}
+
+// secretUsers maps a Secret to the UserpassUsers reading their password from
+// it, so that rotating the password in the Secret re-applies them.
+func secretUsers(kube client.Reader) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		users := &v1alpha1.UserpassUserList{}
+		if err := kube.List(context.TODO(), users); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0)
+
+		for i := range users.Items {
+			ref := users.Items[i].Spec.ForProvider.PasswordSecretRef
+
+			if ref != nil && ref.Name == obj.GetName() && ref.Namespace == obj.GetNamespace() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: users.Items[i].GetName()}})
+			}
+		}
+
+		return requests
+	}
+}
+
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn clients.GetAuthManager
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return nil, errors.New(errNotUserpassUser)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &v1alpha12.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	ref := pc.Spec.Credentials.ConnectionSecretRef
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(s.Data)
+
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{service: svc, kube: c.kube, generate: generatePassword, now: time.Now}, nil
+}
+
+type external struct {
+	service  clients.AuthManager
+	kube     client.Reader
+	generate func(length int) (string, error)
+	now      func() time.Time
+}
+
+// userName returns the name of the user in Vault: the external name of the
+// UserpassUser, falling back to its name.
+func userName(cr *v1alpha1.UserpassUser) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+
+	return cr.GetName()
+}
+
+// mount returns the path the userpass auth method is enabled at.
+func mount(cr *v1alpha1.UserpassUser) string {
+	if m := strings.Trim(cr.Spec.ForProvider.Mount, "/"); m != "" {
+		return m
+	}
+
+	return defaultMount
+}
+
+// generatePassword returns a random alphanumeric password.
+func generatePassword(length int) (string, error) {
+	if length <= 0 {
+		length = defaultPasswordLength
+	}
+
+	chars := big.NewInt(int64(len(passwordChars)))
+	password := make([]byte, length)
+
+	for i := range password {
+		n, err := rand.Int(rand.Reader, chars)
+		if err != nil {
+			return "", err
+		}
+
+		password[i] = passwordChars[n.Int64()]
+	}
+
+	return string(password), nil
+}
+
+// referencedPassword returns the password read from the referenced Secret
+// along with the resourceVersion of the Secret, or empty strings when the
+// provider generates the password.
+func (c *external) referencedPassword(ctx context.Context, cr *v1alpha1.UserpassUser) (string, string, error) {
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	if ref == nil {
+		return "", "", nil
+	}
+
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", "", errors.Wrap(errors.Wrapf(err, common.ErrGetSecretKey, ref.Namespace, ref.Name), errGetPassword)
+	}
+
+	password, ok := s.Data[ref.Key]
+	if !ok {
+		return "", "", errors.Wrap(errors.Errorf(common.ErrNoSecretKey, ref.Namespace, ref.Name, ref.Key), errGetPassword)
+	}
+
+	// An empty password would otherwise be replaced by a generated one.
+	if len(password) == 0 {
+		return "", "", errors.Wrap(errors.Errorf(errEmptyPassword, ref.Namespace, ref.Name, ref.Key), errGetPassword)
+	}
+
+	return string(password), s.GetResourceVersion(), nil
+}
+
+// rotationDue tells why the password must be written again, if it must: the
+// provider never published one, a rotation was requested through the
+// annotation, or the referenced Secret changed. Any change to the Secret
+// counts, since only its resourceVersion is tracked.
+func (c *external) rotationDue(ctx context.Context, cr *v1alpha1.UserpassUser) (string, error) {
+	o := cr.Status.AtProvider
+
+	if o.PasswordLastSetTime == nil {
+		return diffPasswordMissing, nil
+	}
+
+	if rotation := cr.GetAnnotations()[v1alpha1.AnnotationKeyRotatePassword]; rotation != "" && rotation != o.PasswordRotation {
+		return diffRotationRequested, nil
+	}
+
+	if cr.Spec.ForProvider.PasswordSecretRef == nil {
+		return "", nil
+	}
+
+	_, version, err := c.referencedPassword(ctx, cr)
+
+	if err != nil {
+		return "", err
+	}
+
+	if version != o.PasswordSecretVersion {
+		return diffPasswordChanged, nil
+	}
+
+	return "", nil
+}
+
+// put writes the user to Vault along with a new password, which it returns
+// as connection details.
+func (c *external) put(ctx context.Context, cr *v1alpha1.UserpassUser) (managed.ConnectionDetails, error) {
+	password, version, err := c.referencedPassword(ctx, cr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if password == "" {
+		if password, err = c.generate(cr.Spec.ForProvider.PasswordLength); err != nil {
+			return nil, errors.Wrap(err, errGeneratePassword)
+		}
+	}
+
+	user := clients.UserpassUser{
+		Password: password,
+		Token:    common.TokenConfig(cr.Spec.ForProvider.TokenParameters),
+	}
+
+	if err := c.service.PutUserpassUser(ctx, mount(cr), userName(cr), user); err != nil {
+		return nil, errors.Wrap(err, errPutUser)
+	}
+
+	set := metav1.NewTime(c.now())
+	cr.Status.AtProvider.PasswordLastSetTime = &set
+	cr.Status.AtProvider.PasswordSecretVersion = version
+	cr.Status.AtProvider.PasswordRotation = cr.GetAnnotations()[v1alpha1.AnnotationKeyRotatePassword]
+
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyUsername: []byte(userName(cr)),
+		v1alpha1.ConnectionKeyPassword: []byte(password),
+	}, nil
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUserpassUser)
+	}
+
+	user, err := c.service.GetUserpassUser(ctx, mount(cr), userName(cr))
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetUser)
+	}
+
+	if user == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.Status.AtProvider.Path = fmt.Sprintf("auth/%s/users/%s", mount(cr), userName(cr))
+	cr.Status.AtProvider.TokenPolicies = user.Token.Policies
+
+	details := managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyUsername: []byte(userName(cr)),
+	}
+
+	// The password Secret of a deleted user may be gone already, and is not
+	// needed to delete it.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: details,
+		}, nil
+	}
+
+	drifts := common.TokenDrift(cr.Spec.ForProvider.TokenParameters, &user.Token)
+
+	due, err := c.rotationDue(ctx, cr)
+
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if due != "" {
+		drifts = append(drifts, due)
+	}
+
+	if len(drifts) > 0 {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              strings.Join(drifts, "; "),
+			ConnectionDetails: details,
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: details,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUserpassUser)
+	}
+
+	// Vault requires a password to create the user, but the status of the
+	// UserpassUser is reverted once Create returns, so the provider could not
+	// tell that a password was published. The user is created with a random
+	// password that is never published, and the first Update writes the real
+	// one.
+	password, err := c.generate(cr.Spec.ForProvider.PasswordLength)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+	}
+
+	user := clients.UserpassUser{
+		Password: password,
+		Token:    common.TokenConfig(cr.Spec.ForProvider.TokenParameters),
+	}
+
+	if err := c.service.PutUserpassUser(ctx, mount(cr), userName(cr), user); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPutUser)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes the user and, when its password is due for rotation,
+// publishes a new one. Otherwise the password is left unchanged.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUserpassUser)
+	}
+
+	due, err := c.rotationDue(ctx, cr)
+
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if due != "" {
+		details, err := c.put(ctx, cr)
+
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+
+		return managed.ExternalUpdate{
+			ConnectionDetails: details,
+		}, nil
+	}
+
+	user := clients.UserpassUser{Token: common.TokenConfig(cr.Spec.ForProvider.TokenParameters)}
+
+	if err := c.service.PutUserpassUser(ctx, mount(cr), userName(cr), user); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPutUser)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return errors.New(errNotUserpassUser)
+	}
+
+	return errors.Wrap(c.service.DeleteUserpassUser(ctx, mount(cr), userName(cr)), errDeleteUser)
+}
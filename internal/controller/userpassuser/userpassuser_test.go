@@ -0,0 +1,393 @@
+package userpassuser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/munditrade/provider-secret/apis/vault/v1alpha1"
+	"github.com/munditrade/provider-secret/internal/clients"
+	"github.com/munditrade/provider-secret/internal/common"
+)
+
+var set = v1.NewTime(time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC))
+
+func clock() time.Time {
+	return set.Time
+}
+
+func generated(_ int) (string, error) {
+	return "generated-password", nil
+}
+
+func passwordSecret(password string, version string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(password)}
+		obj.SetResourceVersion(version)
+		return nil
+	}
+}
+
+func user(ref bool, annotations map[string]string, status v1alpha1.UserpassUserObservation) *v1alpha1.UserpassUser {
+	cr := &v1alpha1.UserpassUser{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "break-glass",
+			Annotations: annotations,
+		},
+		Spec: v1alpha1.UserpassUserSpec{
+			ForProvider: v1alpha1.UserpassUserParameters{
+				TokenParameters: v1alpha1.TokenParameters{
+					TokenPolicies: []string{"admin"},
+				},
+			},
+		},
+		Status: v1alpha1.UserpassUserStatus{
+			AtProvider: status,
+		},
+	}
+
+	if ref {
+		cr.Spec.ForProvider.PasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "break-glass"},
+			Key:             "password",
+		}
+	}
+
+	return cr
+}
+
+func TestUserpassUser_Observe(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	type want struct {
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	current := &clients.UserpassUser{
+		Token: clients.TokenConfig{Policies: []string{"admin"}},
+	}
+
+	username := managed.ConnectionDetails{v1alpha1.ConnectionKeyUsername: []byte("break-glass")}
+	published := v1alpha1.UserpassUserObservation{PasswordLastSetTime: &set, PasswordSecretVersion: "1", PasswordRotation: "2022-10-01"}
+
+	cases := map[string]struct {
+		reason      string
+		cr          *v1alpha1.UserpassUser
+		get         test.MockGetFn
+		want        want
+		prepareMock prepareMock
+	}{
+		"should not exist when Vault has no such user": {
+			cr: user(false, nil, v1alpha1.UserpassUserObservation{}),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    false,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(nil, nil).Times(1)
+			},
+		},
+		"should be up to date while the generated password is published": {
+			cr: user(false, map[string]string{v1alpha1.AnnotationKeyRotatePassword: "2022-10-01"}, published),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: username,
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1)
+			},
+		},
+		"should generate a password for a user it never published one for": {
+			cr: user(false, map[string]string{v1alpha1.AnnotationKeyRotatePassword: "2022-10-01"}, v1alpha1.UserpassUserObservation{}),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              diffPasswordMissing,
+					ConnectionDetails: username,
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1)
+			},
+		},
+		"should rotate the password when the annotation changes": {
+			cr: user(false, map[string]string{v1alpha1.AnnotationKeyRotatePassword: "2022-11-01"}, published),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              diffRotationRequested,
+					ConnectionDetails: username,
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1)
+			},
+		},
+		"should be up to date while the referenced Secret is unchanged": {
+			cr:  user(true, map[string]string{v1alpha1.AnnotationKeyRotatePassword: "2022-10-01"}, published),
+			get: passwordSecret("hunter2", "1"),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: username,
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1)
+			},
+		},
+		"should re-apply when the referenced Secret changes": {
+			cr:  user(true, nil, published),
+			get: passwordSecret("correct-horse", "2"),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "token policies differ; " + diffPasswordChanged,
+					ConnectionDetails: username,
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(&clients.UserpassUser{}, nil).Times(1)
+			},
+		},
+		"should fail when the referenced password cannot be read": {
+			cr:  user(true, nil, published),
+			get: test.NewMockGetFn(errors.New("boom")),
+			want: want{
+				err: errors.Wrap(errors.Wrapf(errors.New("boom"), common.ErrGetSecretKey, "crossplane-system", "break-glass"), errGetPassword),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1)
+			},
+		},
+		"should fail rather than generate a password when the referenced one is empty": {
+			cr:  user(true, nil, published),
+			get: passwordSecret("", "2"),
+			want: want{
+				err: errors.Wrap(errors.Errorf(errEmptyPassword, "crossplane-system", "break-glass", "password"), errGetPassword),
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1)
+			},
+		},
+		"should not read the referenced password of a deleted user": {
+			cr: func() *v1alpha1.UserpassUser {
+				cr := user(true, nil, published)
+				cr.SetDeletionTimestamp(&v1.Time{Time: time.Now()})
+				return cr
+			}(),
+			get: test.NewMockGetFn(errors.New("boom")),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: username,
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, kube: &test.MockClient{MockGet: testCase.get}, generate: generated, now: clock}
+
+			got, err := e.Observe(context.Background(), testCase.cr)
+
+			if diff := cmp.Diff(testCase.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", testCase.reason, diff)
+			}
+			if diff := cmp.Diff(testCase.want.observation, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUserpassUser_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := clients.NewMockAuthManager(ctrl)
+	mock.EXPECT().PutUserpassUser(gomock.Any(), "userpass", "break-glass", clients.UserpassUser{
+		Password: "generated-password",
+		Token:    clients.TokenConfig{Policies: []string{"admin"}},
+	}).Return(nil).Times(1)
+
+	e := external{service: mock, generate: generated, now: clock}
+	cr := user(true, nil, v1alpha1.UserpassUserObservation{})
+
+	got, err := e.Create(context.Background(), cr)
+
+	if err != nil {
+		t.Errorf("e.Create(...): unexpected error: %v", err)
+	}
+
+	want := managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+// TestUserpassUser_Lifecycle walks a UserpassUser through its first
+// reconciles, re-fetching it after Create the way the managed reconciler
+// does, to check that the referenced password ends up written and published.
+func TestUserpassUser_Lifecycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	current := &clients.UserpassUser{
+		Token: clients.TokenConfig{Policies: []string{"admin"}},
+	}
+
+	mock := clients.NewMockAuthManager(ctrl)
+	gomock.InOrder(
+		mock.EXPECT().PutUserpassUser(gomock.Any(), "userpass", "break-glass", clients.UserpassUser{
+			Password: "generated-password",
+			Token:    clients.TokenConfig{Policies: []string{"admin"}},
+		}).Return(nil).Times(1),
+		mock.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1),
+		mock.EXPECT().PutUserpassUser(gomock.Any(), "userpass", "break-glass", clients.UserpassUser{
+			Password: "hunter2",
+			Token:    clients.TokenConfig{Policies: []string{"admin"}},
+		}).Return(nil).Times(1),
+		mock.EXPECT().GetUserpassUser(gomock.Any(), "userpass", "break-glass").Return(current, nil).Times(1),
+	)
+
+	e := external{service: mock, kube: &test.MockClient{MockGet: passwordSecret("hunter2", "1")}, generate: generated, now: clock}
+
+	if _, err := e.Create(context.Background(), user(true, nil, v1alpha1.UserpassUserObservation{})); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+
+	// The reconciler re-fetches the UserpassUser once Create returns, which
+	// reverts any status Create recorded.
+	cr := user(true, nil, v1alpha1.UserpassUserObservation{})
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if o.ResourceUpToDate || o.Diff != diffPasswordMissing {
+		t.Fatalf("e.Observe(...): want a missing password, got %+v", o)
+	}
+
+	u, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]byte("hunter2"), u.ConnectionDetails[v1alpha1.ConnectionKeyPassword]); diff != "" {
+		t.Errorf("e.Update(...): -want password, +got password:\n%s\n", diff)
+	}
+
+	status := v1alpha1.UserpassUserObservation{
+		Path:                  "auth/userpass/users/break-glass",
+		TokenPolicies:         []string{"admin"},
+		PasswordLastSetTime:   &set,
+		PasswordSecretVersion: "1",
+	}
+	if diff := cmp.Diff(status, cr.Status.AtProvider); diff != "" {
+		t.Errorf("e.Update(...): -want status, +got status:\n%s\n", diff)
+	}
+
+	o, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want up to date after the password was published, got %+v", o)
+	}
+}
+
+func TestUserpassUser_Update(t *testing.T) {
+	type prepareMock func(m *clients.MockAuthManager)
+
+	published := v1alpha1.UserpassUserObservation{PasswordLastSetTime: &set, PasswordSecretVersion: "1"}
+
+	cases := map[string]struct {
+		reason      string
+		cr          *v1alpha1.UserpassUser
+		want        managed.ExternalUpdate
+		prepareMock prepareMock
+	}{
+		"should leave the password unchanged when no rotation is due": {
+			cr: user(true, nil, published),
+			want: managed.ExternalUpdate{
+				ConnectionDetails: managed.ConnectionDetails{},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().PutUserpassUser(gomock.Any(), "userpass", "break-glass", clients.UserpassUser{
+					Token: clients.TokenConfig{Policies: []string{"admin"}},
+				}).Return(nil).Times(1)
+			},
+		},
+		"should publish the referenced password again when rotation is requested": {
+			cr: user(true, map[string]string{v1alpha1.AnnotationKeyRotatePassword: "now"}, published),
+			want: managed.ExternalUpdate{
+				ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionKeyUsername: []byte("break-glass"),
+					v1alpha1.ConnectionKeyPassword: []byte("hunter2"),
+				},
+			},
+			prepareMock: func(m *clients.MockAuthManager) {
+				m.EXPECT().PutUserpassUser(gomock.Any(), "userpass", "break-glass", clients.UserpassUser{
+					Password: "hunter2",
+					Token:    clients.TokenConfig{Policies: []string{"admin"}},
+				}).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		testCase := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := clients.NewMockAuthManager(ctrl)
+			testCase.prepareMock(mock)
+			e := external{service: mock, kube: &test.MockClient{MockGet: passwordSecret("hunter2", "1")}, generate: generated, now: clock}
+
+			got, err := e.Update(context.Background(), testCase.cr)
+
+			if err != nil {
+				t.Errorf("\n%s\ne.Update(...): unexpected error: %v", testCase.reason, err)
+			}
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", testCase.reason, diff)
+			}
+		})
+	}
+}